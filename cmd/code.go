@@ -1,41 +1,56 @@
-// +build debug
-
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"github.com/yoozoo/protoapi/generator"
+	"github.com/yoozoo/protoapi/util"
 
 	"github.com/spf13/cobra"
 )
 
 // codeCmd represents the code command
 var codeCmd = &cobra.Command{
-	Use:   "code <file>",
-	Short: "read binary protobuf ast data and print generated code to stdout",
-	Long: `a command to assist in testing code generation. It reads binary protobuf ast data, generate
-	code accordingly and print the generated file content with file name to stdout. The binary data file can be
-	created by protoc-gen-dump. When dumping, u need to supply proper parameters on the protoc command line which
-	is then being embeded into the data file for this command to use`,
-	Args: cobra.ExactArgs(1),
+	Use:   "code <request file> [output dir]",
+	Short: "generate code from a serialized CodeGeneratorRequest",
+	Long: `This command will read a binary CodeGeneratorRequest, the input protoc gives its plugins, and generate code
+like protoc running protoapi would. The parameters of the request select the language and the options, ie lang=go.
+The generated files are written to the output directory, or printed with their names to stdout when none is given.
+The request file can be created by protoc-gen-dump.`,
+	Args: cobra.RangeArgs(1, 2),
 	Run:  outputCode,
 }
 
 func outputCode(cmd *cobra.Command, args []string) {
-
-	input, err := ioutil.ReadFile(args[0])
+	input, err := ioutil.ReadFile(filepath.FromSlash(args[0]))
 	if err != nil {
-		panic(fmt.Errorf("reading file %s error: %s", args[0], err))
+		util.Die(fmt.Errorf("reading file %s error: %s", args[0], err))
 	}
 
 	response := generator.Generate(input)
 
+	if len(args) == 2 {
+		outputDir := filepath.FromSlash(args[1])
+		if stat, err := os.Stat(outputDir); err != nil || !stat.IsDir() {
+			util.Die(fmt.Errorf("Output directory %s is not accessible", outputDir))
+		}
+		if err := writeResponse(response, outputDir); err != nil {
+			util.Die(err)
+		}
+		return
+	}
+
+	if response.Error != nil {
+		util.Die(errors.New(response.GetError()))
+	}
 	for _, file := range response.File {
-		fmt.Println(*file.Name)
+		fmt.Println(file.GetName())
 		fmt.Println("-----------------------------------------------------")
-		fmt.Println(*file.Content)
+		fmt.Println(file.GetContent())
 		fmt.Println("")
 		fmt.Println("")
 		fmt.Println("")
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	"github.com/yoozoo/protoapi/generator"
+	"github.com/yoozoo/protoapi/util"
+
+	"github.com/spf13/cobra"
+)
+
+const changelogFlag = "changelog"
+
+type diffFlagData struct {
+	protoIncPath string
+	changelog    bool
+}
+
+func (d *diffFlagData) reset() {
+	d.protoIncPath = ""
+	d.changelog = false
+}
+
+var diffFlagValue diffFlagData
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff <old proto file|dir|descriptor set> <new proto file|dir|descriptor set>",
+	Short: "report the breaking changes between two versions of an API",
+	Long: `This command will compare two versions of an API and print the changes breaking the wire format or the JSON API,
+like removed fields, changed field numbers or types, removed methods or routes. The command fails if there is any.
+A version is given as proto files, a directory of proto files or a descriptor set created by protoc --descriptor_set_out --include_imports.`,
+	Args: cobra.ExactArgs(2),
+	Run:  diffAPI,
+}
+
+func diffAPI(cmd *cobra.Command, args []string) {
+	defer func() {
+		diffFlagValue.reset()
+	}()
+
+	oldAPI, err := loadAPI(args[0])
+	if err != nil {
+		util.Die(err)
+	}
+	newAPI, err := loadAPI(args[1])
+	if err != nil {
+		util.Die(err)
+	}
+
+	var breaking, compatible []string
+	for _, change := range generator.Diff(oldAPI, newAPI) {
+		if change.Breaking {
+			breaking = append(breaking, change.Message)
+		} else {
+			compatible = append(compatible, change.Message)
+		}
+	}
+
+	if diffFlagValue.changelog {
+		printChangelog(breaking, compatible)
+	} else {
+		for _, change := range breaking {
+			fmt.Println(change)
+		}
+	}
+
+	switch len(breaking) {
+	case 0:
+	case 1:
+		util.Die(fmt.Errorf("1 breaking change found"))
+	default:
+		util.Die(fmt.Errorf("%d breaking changes found", len(breaking)))
+	}
+}
+
+// printChangelog prints the changes as markdown lists, the breaking changes first
+func printChangelog(breaking []string, compatible []string) {
+	fmt.Println("# Changelog")
+	for _, section := range []struct {
+		title   string
+		changes []string
+	}{{"Breaking changes", breaking}, {"Compatible changes", compatible}} {
+		if len(section.changes) == 0 {
+			continue
+		}
+		fmt.Printf("\n## %s\n\n", section.title)
+		for _, change := range section.changes {
+			fmt.Printf("* %s\n", change)
+		}
+	}
+	if len(breaking) == 0 && len(compatible) == 0 {
+		fmt.Println("\nNo changes.")
+	}
+}
+
+// loadAPI returns the data model of a version of the API, the input is a descriptor set
+// or proto files compiled with protoc
+func loadAPI(input string) (*generator.API, error) {
+	content, err := readDescriptorSet(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var set descriptor.FileDescriptorSet
+	if err := proto.Unmarshal(content, &set); err != nil {
+		return nil, fmt.Errorf("%s is not a descriptor set: %s", input, err)
+	}
+	api, err := generator.LoadAPI(set.File)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", input, err)
+	}
+	return api, nil
+}
+
+// readDescriptorSet returns the content of a descriptor set file, or the descriptor set protoc creates
+// from the proto files of the input. The files other than .proto are descriptor sets
+func readDescriptorSet(input string) ([]byte, error) {
+	input = filepath.FromSlash(input)
+	if stat, err := os.Stat(input); err == nil && !stat.IsDir() && filepath.Ext(input) != ".proto" {
+		return ioutil.ReadFile(input)
+	}
+
+	outputDir, err := ioutil.TempDir("", "protoapi_diff_")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(outputDir)
+
+	file := filepath.Join(outputDir, "descriptor_set.pb")
+	if err := runProtoc("", diffFlagValue.protoIncPath, []string{"--descriptor_set_out=" + file, "--include_imports"}, []string{input}); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(file)
+}
+
+func init() {
+	RootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringVar(&diffFlagValue.protoIncPath, protoPathFlag, "", "extra proto file import paths, seperated by ':'(unix) or ';'(windows)")
+	diffCmd.Flags().BoolVar(&diffFlagValue.changelog, changelogFlag, false, "print all the changes as a markdown changelog, the compatible ones included")
+}
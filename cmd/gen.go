@@ -1,12 +1,20 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"strings"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+
+	"github.com/yoozoo/protoapi/generator"
 	"github.com/yoozoo/protoapi/generator/data"
 	"github.com/yoozoo/protoapi/util"
 
@@ -19,6 +27,9 @@ const (
 	langFlag             = "lang"
 	protoPathFlag        = "proto_path"
 	protoCustomParamFlag = "custom_params"
+	jsonNamingFlag       = "json_naming"
+	templateDirFlag      = "template_dir"
+	descriptorSetInFlag  = "descriptor_set_in"
 )
 
 type genFlagData struct {
@@ -26,6 +37,9 @@ type genFlagData struct {
 	protocPath       string
 	protoIncPath     string
 	protoCustomParam string
+	jsonNaming       string
+	templateDir      string
+	descriptorSetIn  string
 }
 
 func (g *genFlagData) reset() {
@@ -33,17 +47,25 @@ func (g *genFlagData) reset() {
 	g.protocPath = ""
 	g.protoIncPath = ""
 	g.protoCustomParam = ""
+	g.jsonNaming = ""
+	g.templateDir = ""
+	g.descriptorSetIn = ""
 }
 
 var genFlagValue genFlagData
 
 // genCmd represents the gen command
 var genCmd = &cobra.Command{
-	Use:   "gen <output dir> <proto file>",
-	Short: "generate code from proto file",
-	Long:  `This command will read the input proto file and generate code of the requested language to the output directory.`,
-	Args:  cobra.ExactArgs(2),
-	Run:   generateCode,
+	Use:   "gen <output dir> <proto file|dir>...",
+	Short: "generate code from proto files",
+	Long: `This command will read the input proto files and generate code of the requested language to the output directory.
+When a directory is given, all the .proto files under it are used and the directory is added to the import paths.
+
+With --descriptor_set_in the code is generated from a descriptor set created by protoc --descriptor_set_out --include_imports
+or buf, without running protoc. The proto files are then given by their names in the set, ie dir/file.proto, and default to
+the files of the set that no other file imports.`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  generateCode,
 }
 
 func generateCode(cmd *cobra.Command, args []string) {
@@ -51,23 +73,31 @@ func generateCode(cmd *cobra.Command, args []string) {
 		genFlagValue.reset()
 	}()
 
-	executable, _ := os.Executable()
-
-	var params = make(map[string]string)
-	params[langFlag] = genFlagValue.langValue
-
-	if _, ok := data.OutputMap[genFlagValue.langValue]; !ok {
+	if _, ok := data.GetOutputPlugin(genFlagValue.langValue); !ok {
 		err := fmt.Errorf("Output plugin not found for %s\nsupported options: %v",
 			genFlagValue.langValue, reflect.ValueOf(data.OutputMap).MapKeys())
 		util.Die(err)
 	}
 
-	protoc := genFlagValue.protocPath
+	// protoc runs protoapi in its own working directory, resolve the external generator now
+	if strings.HasPrefix(genFlagValue.langValue, data.ExecLangPrefix) {
+		genFlagValue.langValue = data.ExecLangPrefix + getExecutable(strings.TrimPrefix(genFlagValue.langValue, data.ExecLangPrefix))
+	}
 
-	if len(protoc) == 0 {
-		protoc, genFlagValue.protoIncPath = util.GetDefaultProtoc(genFlagValue.protoIncPath)
+	var params = make(map[string]string)
+	params[langFlag] = genFlagValue.langValue
+	params[jsonNamingFlag] = genFlagValue.jsonNaming
+
+	if len(genFlagValue.templateDir) > 0 {
+		templateDir, err := filepath.Abs(filepath.FromSlash(genFlagValue.templateDir))
+		if err != nil {
+			util.Die(err)
+		}
+		if stat, err := os.Stat(templateDir); err != nil || !stat.IsDir() {
+			util.Die(fmt.Errorf("Template directory %s is not accessible", templateDir))
+		}
+		params[templateDirFlag] = templateDir
 	}
-	protoc = filepath.FromSlash(protoc)
 
 	cmdParam := ""
 	for name, value := range params {
@@ -83,41 +113,204 @@ func generateCode(cmd *cobra.Command, args []string) {
 		cmdParam += "," + genFlagValue.protoCustomParam
 	}
 
-	protoFile := filepath.FromSlash(args[1])
-	stat, err := os.Stat(protoFile)
-	if err != nil {
-		util.Die(fmt.Errorf("Input %s is not accessible : %s", protoFile, err.Error()))
-	}
-	if stat.IsDir() {
-		util.Die(fmt.Errorf("Input %s is not a file", protoFile))
-	}
 	outputDir := filepath.FromSlash(args[0])
-	stat, err = os.Stat(outputDir)
+	stat, err := os.Stat(outputDir)
 	if err != nil || !stat.IsDir() {
 		util.Die(fmt.Errorf("Output directory %s is not accessible", outputDir))
 	}
 
+	if len(genFlagValue.descriptorSetIn) > 0 {
+		if err := generateFromDescriptorSet(genFlagValue.descriptorSetIn, cmdParam, outputDir, args[1:]); err != nil {
+			util.Die(err)
+		}
+		return
+	}
+	if len(args) < 2 {
+		util.Die(errors.New("No proto file given"))
+	}
+
+	if err := runProtoc(genFlagValue.protocPath, genFlagValue.protoIncPath, pluginArgs(cmdParam, outputDir), args[1:]); err != nil {
+		util.Die(err)
+	}
+}
+
+// generateFromDescriptorSet generates the files to the output directory like protoc would run protoapi,
+// the proto files are read from the descriptor set instead of being compiled
+func generateFromDescriptorSet(setFile string, cmdParam string, outputDir string, files []string) error {
+	content, err := ioutil.ReadFile(filepath.FromSlash(setFile))
+	if err != nil {
+		return err
+	}
+	var set descriptor.FileDescriptorSet
+	if err := proto.Unmarshal(content, &set); err != nil {
+		return fmt.Errorf("%s is not a descriptor set: %s", setFile, err)
+	}
+
+	names := make(map[string]bool)
+	imported := make(map[string]bool)
+	for _, file := range set.File {
+		names[file.GetName()] = true
+		for _, dep := range file.GetDependency() {
+			imported[dep] = true
+		}
+	}
+	for dep := range imported {
+		if !names[dep] {
+			return fmt.Errorf("%s is imported but not in %s, create the descriptor set with --include_imports", dep, setFile)
+		}
+	}
+
+	if len(files) == 0 {
+		for _, file := range set.File {
+			if !imported[file.GetName()] {
+				files = append(files, file.GetName())
+			}
+		}
+	}
+	for i, file := range files {
+		files[i] = filepath.ToSlash(file)
+		if !names[files[i]] {
+			return fmt.Errorf("%s is not in %s", file, setFile)
+		}
+	}
+
+	request := &plugin.CodeGeneratorRequest{
+		FileToGenerate: files,
+		Parameter:      proto.String(cmdParam),
+		ProtoFile:      set.File,
+	}
+	response, err := generator.GenerateWithOptions(request, nil)
+	if err != nil {
+		return err
+	}
+	return writeResponse(response, outputDir)
+}
+
+// writeResponse writes the generated files under the output directory, or returns the error of the response
+func writeResponse(response *plugin.CodeGeneratorResponse, outputDir string) error {
+	if response.Error != nil {
+		return errors.New(response.GetError())
+	}
+	for _, file := range response.File {
+		path := filepath.Join(outputDir, filepath.FromSlash(file.GetName()))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, []byte(file.GetContent()), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pluginArgs returns the protoc arguments running protoapi as the plugin,
+// cmdParam is the parameter of the plugin and the generated files go to outputDir
+func pluginArgs(cmdParam string, outputDir string) []string {
+	executable, _ := os.Executable()
+	arglist := []string{"--plugin=protoc-gen-custom=" + executable}
+	if strings.Contains(cmdParam, ":") {
+		// --custom_out splits the parameters from the output directory at the first ':'
+		arglist = append(arglist, "--custom_opt="+cmdParam)
+		arglist = append(arglist, "--custom_out="+outputDir)
+	} else {
+		arglist = append(arglist, "--custom_out="+cmdParam+":"+outputDir)
+	}
+	return arglist
+}
+
+// runProtoc runs protoc on the proto files of the inputs, outputArgs tells protoc what to output
+func runProtoc(protoc string, protoIncPath string, outputArgs []string, inputs []string) error {
+	if len(protoc) == 0 {
+		protoc, protoIncPath = util.GetDefaultProtoc(protoIncPath)
+	}
+	protoc = filepath.FromSlash(protoc)
+
+	protoFiles, protoDirs := getProtoFiles(inputs)
+	if len(protoFiles) == 0 {
+		return fmt.Errorf("No proto file found in %v", inputs)
+	}
+
 	var arglist []string
 
-	protoIncPath := util.GetIncludePath(filepath.FromSlash(genFlagValue.protoIncPath), filepath.Dir(protoFile))
+	protoIncPath = util.GetIncludePath(filepath.FromSlash(protoIncPath), strings.Join(protoDirs, string(os.PathListSeparator)))
 	arglist = append(arglist, "--"+protoPathFlag+"="+protoIncPath)
-	arglist = append(arglist, "--plugin=protoc-gen-custom="+executable)
-	arglist = append(arglist, "--custom_out="+cmdParam+":"+outputDir)
-	arglist = append(arglist, protoFile)
+	arglist = append(arglist, outputArgs...)
+	arglist = append(arglist, protoFiles...)
 	protoCmd := exec.Command(protoc, arglist...)
 
 	protoCmd.Stderr = os.Stderr
-	err = protoCmd.Run()
+	if err := protoCmd.Run(); err != nil {
+		return fmt.Errorf("Error to execute protoc: %s", err)
+	}
+	return nil
+}
+
+// getExecutable returns the absolute path of an external generator, searching PATH for bare names
+func getExecutable(name string) string {
+	if name == "" {
+		util.Die(fmt.Errorf("No generator executable given, use --%s=%s/path/to/generator", langFlag, data.ExecLangPrefix))
+	}
+
+	file, err := exec.LookPath(filepath.FromSlash(name))
+	if err != nil {
+		util.Die(fmt.Errorf("Generator %s is not executable: %s", name, err))
+	}
 
+	file, err = filepath.Abs(file)
 	if err != nil {
-		util.Die(fmt.Errorf("Error to execute protoc: %s", err))
+		util.Die(err)
+	}
+	return file
+}
+
+// getProtoFiles expands the input arguments to proto files.
+// Directories are walked recursively for .proto files, and used as import path.
+// Returns the proto files and the import paths needed to find them.
+func getProtoFiles(inputs []string) (files []string, dirs []string) {
+	addDir := func(dir string) {
+		if !util.IsStrInSlice(dir, dirs) {
+			dirs = append(dirs, dir)
+		}
 	}
+
+	for _, input := range inputs {
+		input = filepath.FromSlash(input)
+		stat, err := os.Stat(input)
+		if err != nil {
+			util.Die(fmt.Errorf("Input %s is not accessible : %s", input, err.Error()))
+		}
+
+		if !stat.IsDir() {
+			files = append(files, input)
+			addDir(filepath.Dir(input))
+			continue
+		}
+
+		addDir(input)
+		err = filepath.Walk(input, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && filepath.Ext(path) == ".proto" {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			util.Die(fmt.Errorf("Failed to read directory %s : %s", input, err.Error()))
+		}
+	}
+
+	return
 }
 
 func init() {
 	RootCmd.AddCommand(genCmd)
 
-	genCmd.Flags().StringVar(&genFlagValue.langValue, langFlag, "", "language of the generated code, default is ts.")
+	genCmd.Flags().StringVar(&genFlagValue.langValue, langFlag, "", "language of the generated code, default is ts. exec:<path> runs an external generator.")
 	genCmd.Flags().StringVar(&genFlagValue.protoIncPath, protoPathFlag, "", "extra proto file import paths, seperated by ':'(unix) or ';'(windows)")
 	genCmd.Flags().StringVar(&genFlagValue.protoCustomParam, protoCustomParamFlag, "", "custom parameters to the specific plugin, <key>=<value> separated by ',' ")
+	genCmd.Flags().StringVar(&genFlagValue.jsonNaming, jsonNamingFlag, "", "JSON keys of the message fields, original (proto field names, default) or camel (lowerCamelCase). json_name in the proto file always wins.")
+	genCmd.Flags().StringVar(&genFlagValue.templateDir, templateDirFlag, "", "directory of templates overriding the embedded ones, e.g. <dir>/go/service.gogo replaces /generator/template/go/service.gogo")
+	genCmd.Flags().StringVar(&genFlagValue.descriptorSetIn, descriptorSetInFlag, "", "descriptor set to generate from instead of running protoc, created with --descriptor_set_out --include_imports")
 }
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yoozoo/protoapi/generator/data"
+	"github.com/yoozoo/protoapi/util"
+
+	"github.com/spf13/cobra"
+)
+
+type lintFlagData struct {
+	protoIncPath string
+}
+
+func (l *lintFlagData) reset() {
+	l.protoIncPath = ""
+}
+
+var lintFlagValue lintFlagData
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint <proto file|dir>...",
+	Short: "check proto files against the protoapi conventions",
+	Long: `This command will read the input proto files and report the problems protoc does not know about, like
+an error option naming a message that does not exist or a field option not fitting the field type.
+The problems are printed as file:line:column: message, the command fails if there is any.
+The warnings, the problems the generated code works around, are printed as file:line:column: warning: message
+and do not fail the command.`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  lintProto,
+}
+
+func lintProto(cmd *cobra.Command, args []string) {
+	defer func() {
+		lintFlagValue.reset()
+	}()
+
+	outputDir, err := ioutil.TempDir("", "protoapi_lint_")
+	if err != nil {
+		util.Die(err)
+	}
+	err = runProtoc("", lintFlagValue.protoIncPath, pluginArgs(data.LintParam+"=true", outputDir), args)
+	var problems []byte
+	if err == nil {
+		problems, err = ioutil.ReadFile(filepath.Join(outputDir, data.LintFile))
+	}
+	os.RemoveAll(outputDir)
+	if err != nil {
+		util.Die(err)
+	}
+
+	fmt.Print(string(problems))
+	var count int
+	for _, line := range strings.Split(string(problems), "\n") {
+		if line != "" && !strings.Contains(line, ": warning: ") {
+			count++
+		}
+	}
+	if count > 0 {
+		if count == 1 {
+			util.Die(fmt.Errorf("1 problem found"))
+		}
+		util.Die(fmt.Errorf("%d problems found", count))
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(lintCmd)
+
+	lintCmd.Flags().StringVar(&lintFlagValue.protoIncPath, protoPathFlag, "", "extra proto file import paths, seperated by ':'(unix) or ';'(windows)")
+}
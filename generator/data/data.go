@@ -2,27 +2,42 @@
 package data
 
 import (
-	"os"
+	"strings"
 
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
-	"github.com/yoozoo/protoapi/generator/data/tpl"
-
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 )
 
 const (
-	//IntFieldType datatype string for interge, it is assumed to be of signed and at least 64 bit
-	IntFieldType = "int"
-	//Int32FieldType datatype string for interge 32 bit
+	// DoubleFieldType datatype string for 64 bit floating point
+	DoubleFieldType = "double"
+	// FloatFieldType datatype string for 32 bit floating point
+	FloatFieldType = "float"
+	// Int32FieldType datatype string for interge 32 bit
 	Int32FieldType = "int32"
-	//Int64FieldType datatype string for interge 64 bit
+	// Int64FieldType datatype string for interge 64 bit
 	Int64FieldType = "int64"
+	// UInt32FieldType datatype string for unsigned interge 32 bit
+	UInt32FieldType = "uint32"
+	// UInt64FieldType datatype string for unsigned interge 64 bit
+	UInt64FieldType = "uint64"
+	// SInt32FieldType datatype string for zigzag encoded interge 32 bit
+	SInt32FieldType = "sint32"
+	// SInt64FieldType datatype string for zigzag encoded interge 64 bit
+	SInt64FieldType = "sint64"
+	// Fixed32FieldType datatype string for fixed size unsigned interge 32 bit
+	Fixed32FieldType = "fixed32"
+	// Fixed64FieldType datatype string for fixed size unsigned interge 64 bit
+	Fixed64FieldType = "fixed64"
+	// SFixed32FieldType datatype string for fixed size interge 32 bit
+	SFixed32FieldType = "sfixed32"
+	// SFixed64FieldType datatype string for fixed size interge 64 bit
+	SFixed64FieldType = "sfixed64"
 	// BooleanFieldType datatype string for boolean
 	BooleanFieldType = "bool"
 	// StringFieldType datatype string for string
 	StringFieldType = "string"
-	// DoubleFieldType datatype string for floating point, it is assumed to be at least of ieee double precision
-	DoubleFieldType = "double"
+	// BytesFieldType datatype string for bytes, it is a base64 string in JSON
+	BytesFieldType = "bytes"
 
 	// PathSeparator the path seperator used to form the full key (ie, key/sub_key )
 	PathSeparator = "/"
@@ -30,19 +45,52 @@ const (
 	FieldRepeatedLabel = "LABEL_REPEATED"
 	// JavaPackageOption is Java package option constant
 	JavaPackageOption = "javaPackageOption"
+	// GoImportPrefixParam is the generator parameter giving the go import path of the output directory,
+	// the go packages generated side by side import each other under it, ie example.com/api/todolistsvr
+	GoImportPrefixParam = "go_import_prefix"
+
+	// the protoapi options declared in protoapi_common.proto, they are the keys of OptionMap
 
 	// ServiceAuthOption is service auth option
-	ServiceAuthOption = 51009
+	ServiceAuthOption = "auth"
 	// ServiceCommonErrorOption is service common_error option
-	ServiceCommonErrorOption = 51008
-	// ServiceTypeMethodOption is service method option
-	ServiceTypeMethodOption = 51006
+	ServiceCommonErrorOption = "common_error"
+	// ServiceTypeMethodOption is the HTTP verbs method option, ie "GET" or "GET,POST"
+	ServiceTypeMethodOption = "service_method"
+	// PathMethodOption is the path template method option, ie "/users/{user_id}/orders"
+	PathMethodOption = "path"
+	// ServiceBasePathOption is the path prefix service option
+	ServiceBasePathOption = "base_path"
+	// HTTPRuleExtension is the full name of the google.api.http method option of grpc-gateway,
+	// an alternative to the service_method and path options
+	HTTPRuleExtension = "google.api.http"
 	// ErrorTypeMethodOption is error return type option
-	ErrorTypeMethodOption = 51007
+	ErrorTypeMethodOption = "error"
+	// SunsetMethodOption is the date a deprecated method is removed, ie "2019-06-30"
+	SunsetMethodOption = "sunset"
+	// DeprecationHeadersParam is the generator parameter adding the Deprecation and Sunset
+	// response headers to the deprecated methods of the go servers
+	DeprecationHeadersParam = "deprecation_headers"
 	// FormatFieldOption is the field type validation field option
-	FormatFieldOption = 51002
+	FormatFieldOption = "val_format"
 	// RequiredFieldOption is the required type validation field option
-	RequiredFieldOption = 51003
+	RequiredFieldOption = "val_required"
+	// MinFieldOption is the minimum value validation field option
+	MinFieldOption = "min"
+	// MaxFieldOption is the maximum value validation field option
+	MaxFieldOption = "max"
+	// MinLengthFieldOption is the minimum length validation field option
+	MinLengthFieldOption = "val_min_length"
+	// MaxLengthFieldOption is the maximum length validation field option
+	MaxLengthFieldOption = "val_max_length"
+	// PatternFieldOption is the regular expression validation field option
+	PatternFieldOption = "val_pattern"
+	// MinItemsFieldOption is the minimum item count validation field option
+	MinItemsFieldOption = "val_min_items"
+	// MaxItemsFieldOption is the maximum item count validation field option
+	MaxItemsFieldOption = "val_max_items"
+	// DefinedOnlyFieldOption is the declared enum values validation field option
+	DefinedOnlyFieldOption = "val_defined_only"
 
 	// ComErrMsgName  is common error message name
 	ComErrMsgName = "CommonError"
@@ -56,6 +104,10 @@ const (
 	MessageFieldCommentPath  = 2 // field
 	MessageNestedCommentPath = 3 // nested
 	MessageEnumCommentPath   = 4 // enum
+	MessageOneofCommentPath  = 8 // oneof
+
+	// path numbers in FieldDescriptorProto (describe message fields)
+	FieldJSONNamePath = 10 // json_name
 
 	// path numbers in EnumDescriptorProto (describe enum)
 	EnumFieldCommentPath = 2 // field
@@ -64,102 +116,336 @@ const (
 	ServiceMethodCommentPath = 2
 )
 
-//ServiceOptions is the map of field number and field name in service options
-var ServiceOptions = map[int32]OptionInfo{
-	ServiceCommonErrorOption: OptionInfo{"common_error", (*string)(nil), StringFieldType},
-	ServiceAuthOption:        OptionInfo{"auth", (*bool)(nil), BooleanFieldType},
-}
+// the HTTP verbs the methods are served with, set by the service_method option
+const (
+	HTTPGet    = "GET"
+	HTTPHead   = "HEAD"
+	HTTPPost   = "POST"
+	HTTPPut    = "PUT"
+	HTTPPatch  = "PATCH"
+	HTTPDelete = "DELETE"
+)
+
+// HTTPMethods are the supported HTTP verbs
+var HTTPMethods = []string{HTTPGet, HTTPHead, HTTPPost, HTTPPut, HTTPPatch, HTTPDelete}
 
-// MethodOptions is the map of field number and field name in method options
-var MethodOptions = map[int32]OptionInfo{
-	ServiceTypeMethodOption: OptionInfo{"service_method", (*string)(nil), StringFieldType},
-	ErrorTypeMethodOption:   OptionInfo{"error", (*string)(nil), StringFieldType},
+// well-known types of google/protobuf, they are mapped to native types of the targets instead of being generated
+const (
+	TimestampType   = "google.protobuf.Timestamp"
+	DurationType    = "google.protobuf.Duration"
+	EmptyType       = "google.protobuf.Empty"
+	StructType      = "google.protobuf.Struct"
+	ValueType       = "google.protobuf.Value"
+	ListValueType   = "google.protobuf.ListValue"
+	DoubleValueType = "google.protobuf.DoubleValue"
+	FloatValueType  = "google.protobuf.FloatValue"
+	Int64ValueType  = "google.protobuf.Int64Value"
+	UInt64ValueType = "google.protobuf.UInt64Value"
+	Int32ValueType  = "google.protobuf.Int32Value"
+	UInt32ValueType = "google.protobuf.UInt32Value"
+	BoolValueType   = "google.protobuf.BoolValue"
+	StringValueType = "google.protobuf.StringValue"
+	BytesValueType  = "google.protobuf.BytesValue"
+)
+
+// WellKnownTypeFiles are the google/protobuf files defining the well-known types above
+var WellKnownTypeFiles = []string{
+	"google/protobuf/timestamp.proto",
+	"google/protobuf/duration.proto",
+	"google/protobuf/empty.proto",
+	"google/protobuf/struct.proto",
+	"google/protobuf/wrappers.proto",
 }
 
-// FieldOptions is the map of field number and field name in field options
-var FieldOptions = map[int32]OptionInfo{
-	FormatFieldOption:   OptionInfo{"val_format", (*string)(nil), StringFieldType},
-	RequiredFieldOption: OptionInfo{"val_required", (*bool)(nil), BooleanFieldType},
+// WrapperTypes is the map of wrapper well-known types and the scalar data type they wrap,
+// a wrapper is a nullable scalar in JSON
+var WrapperTypes = map[string]string{
+	DoubleValueType: DoubleFieldType,
+	FloatValueType:  FloatFieldType,
+	Int64ValueType:  Int64FieldType,
+	UInt64ValueType: UInt64FieldType,
+	Int32ValueType:  Int32FieldType,
+	UInt32ValueType: UInt32FieldType,
+	BoolValueType:   BooleanFieldType,
+	StringValueType: StringFieldType,
+	BytesValueType:  BytesFieldType,
 }
 
-var debugTpl = os.Getenv("debugTpl") == "true"
+// IsScalarType returns if the data type is one of the proto scalar types
+func IsScalarType(dataType string) bool {
+	switch dataType {
+	case DoubleFieldType, FloatFieldType,
+		Int32FieldType, Int64FieldType, UInt32FieldType, UInt64FieldType,
+		SInt32FieldType, SInt64FieldType, Fixed32FieldType, Fixed64FieldType,
+		SFixed32FieldType, SFixed64FieldType,
+		BooleanFieldType, StringFieldType, BytesFieldType:
+		return true
+	}
+	return false
+}
 
-// LoadTpl is the function to load template file as string
-// It loads file content from esc embed by default
-// Set environment variable debugTpl to "true" to load template from disk directly
-func LoadTpl(tplPath string) string {
-	//useLocal is true, the filesystem's contents are instead used.
-	return tpl.FSMustString(debugTpl, tplPath)
+// IsWellKnownType returns if the data type is one of the well-known types mapped to native types
+func IsWellKnownType(dataType string) bool {
+	switch dataType {
+	case TimestampType, DurationType, EmptyType, StructType, ValueType, ListValueType:
+		return true
+	}
+	_, ok := WrapperTypes[dataType]
+	return ok
 }
 
-type OptionInfo struct {
-	Name       string
-	DefaultNil interface{}
-	Type       string
+// UsesType returns if a method of the services takes or returns the data type
+func UsesType(services []*ServiceData, dataType string) bool {
+	for _, service := range services {
+		for _, method := range service.Methods {
+			if method.InputType == dataType || method.OutputType == dataType {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // EnumField a enum entry for enum datatype
 type EnumField struct {
-	Name    string // enum entry name
-	Value   int32  // enum entry value
-	Comment string
+	Name       string       `json:"name"`  // enum entry name
+	Value      int32        `json:"value"` // enum entry value
+	Comment    string       `json:"comment"`
+	Comments   *Comments    `json:"comments,omitempty"`
+	Deprecated bool         `json:"deprecated,omitempty"`
+	Extensions ExtensionMap `json:"extensions,omitempty"`
 }
 
 // EnumData a structure to represent a enum datatype
 type EnumData struct {
-	Name    string // enum type name
-	Comment string
-	Fields  []EnumField // enum entries
+	File       string       `json:"file"` // file where this enum is defined
+	Name       string       `json:"name"` // enum type name
+	Comment    string       `json:"comment"`
+	Comments   *Comments    `json:"comments,omitempty"`
+	Fields     []EnumField  `json:"fields"` // enum entries
+	Deprecated bool         `json:"deprecated,omitempty"`
+	Extensions ExtensionMap `json:"extensions,omitempty"`
 }
 
 // MessageField a field for the defined message.
 type MessageField struct {
-	Name     string // message variable name
-	DataType string // message variable type
-	Key      string // coresponding key name for the variable, default is the same as variable name
-	Label    string
-	Comment  string
-	Options  OptionMap
+	Name       string           `json:"name"`     // message variable name
+	Number     int32            `json:"number"`   // field number on the wire
+	DataType   string           `json:"dataType"` // message variable type, value type for map field
+	KeyType    string           `json:"keyType"`  // key type for map field, empty for other fields
+	Key        string           `json:"key"`      // coresponding key name for the variable, default is the same as variable name
+	Label      string           `json:"label"`
+	Comment    string           `json:"comment"`
+	Comments   *Comments        `json:"comments,omitempty"`
+	Options    OptionMap        `json:"options"`
+	Oneof      string           `json:"oneof"`               // name of the oneof group the field belongs to, empty if none
+	Optional   bool             `json:"optional"`            // proto3 optional field, its presence is tracked
+	Recursive  bool             `json:"recursive,omitempty"` // singular field whose message type refers back to the message holding it, it can not be required
+	Deprecated bool             `json:"deprecated,omitempty"`
+	Extensions ExtensionMap     `json:"extensions,omitempty"`
+	Validation *FieldValidation `json:"validation,omitempty"` // nil if the field has no validation rules
+}
+
+// FieldValidation holds the validation rules of a message field, set by the validation options of protoapi_common.proto.
+// The value rules apply to every item of repeated and map fields.
+type FieldValidation struct {
+	Required    bool   `json:"required,omitempty"`    // the field must not be empty
+	Format      string `json:"format,omitempty"`      // string format, only "email" for now
+	Min         *int64 `json:"min,omitempty"`         // minimum of numbers
+	Max         *int64 `json:"max,omitempty"`         // maximum of numbers
+	MinLength   *int64 `json:"minLength,omitempty"`   // minimum length of strings and bytes
+	MaxLength   *int64 `json:"maxLength,omitempty"`   // maximum length of strings and bytes
+	Pattern     string `json:"pattern,omitempty"`     // regular expression strings must match
+	MinItems    *int64 `json:"minItems,omitempty"`    // minimum item count of repeated and map fields
+	MaxItems    *int64 `json:"maxItems,omitempty"`    // maximum item count of repeated and map fields
+	DefinedOnly bool   `json:"definedOnly,omitempty"` // enums must hold a declared value
+}
+
+// HasValueRules returns if there are rules checking the value of the field, or each item of repeated fields
+func (v *FieldValidation) HasValueRules() bool {
+	return v.Format != "" || v.Min != nil || v.Max != nil || v.MinLength != nil || v.MaxLength != nil || v.Pattern != "" || v.DefinedOnly
+}
+
+// HasItemRules returns if there are rules checking the item count of the field
+func (v *FieldValidation) HasItemRules() bool {
+	return v.MinItems != nil || v.MaxItems != nil
+}
+
+// IsMap returns if the field is a map<KeyType, DataType> field
+func (f *MessageField) IsMap() bool {
+	return f.KeyType != ""
 }
 
 // MessageData a structure to represent a message datatype
 type MessageData struct {
-	File    string // file where this message is defined
-	Name    string // name of the message (class, struct)
-	Comment string
-	Fields  []*MessageField // message members
+	File       string          `json:"file"` // file where this message is defined
+	Name       string          `json:"name"` // name of the message (class, struct)
+	Comment    string          `json:"comment"`
+	Comments   *Comments       `json:"comments,omitempty"`
+	Fields     []*MessageField `json:"fields"` // message members, including the members of oneof groups
+	Oneofs     []*OneofData    `json:"oneofs"` // oneof groups of the message
+	Deprecated bool            `json:"deprecated,omitempty"`
+	Extensions ExtensionMap    `json:"extensions,omitempty"`
+}
+
+// OneofData a group of message fields of which at most one is set at the same time
+type OneofData struct {
+	Name     string          `json:"name"`
+	Comment  string          `json:"comment"`
+	Comments *Comments       `json:"comments,omitempty"`
+	Fields   []*MessageField `json:"fields"` // members of the group, shared with MessageData.Fields
 }
 
 type Method struct {
-	Name       string
-	InputType  string
-	OutputType string
-	HttpMtd    string
-	URI        string
-	Comment    string
-	Options    OptionMap // service method option (default is GET and POST)
+	Name       string          `json:"name"`
+	InputType  string          `json:"inputType"`
+	OutputType string          `json:"outputType"`
+	HttpMtd    string          `json:"httpMethod"`           // HTTP verb of the method, the one of the first binding
+	HttpMtds   []string        `json:"httpMethods"`          // all the HTTP verbs the method is served with, set by the service_method option (default is POST)
+	URI        string          `json:"uri"`                  // path relative to the server root, ie CalcService.add
+	Path       string          `json:"path"`                 // path template of the first binding, ie /CalcService.add or /users/{user_id}/orders
+	PathParams []*MessageField `json:"pathParams,omitempty"` // input fields bound from the path, in their order in the path
+	Bindings   []*HTTPBinding  `json:"bindings"`             // all the routes of the method, the clients use the first one
+	Comment    string          `json:"comment"`
+	Comments   *Comments       `json:"comments,omitempty"`
+	Options    OptionMap       `json:"options"`
+	Extensions ExtensionMap    `json:"extensions,omitempty"`
+
+	// ServerStreaming methods send a stream of output messages as Server-Sent Events
+	ServerStreaming bool `json:"serverStreaming"`
+
+	Deprecated bool   `json:"deprecated,omitempty"`
+	Sunset     string `json:"sunset,omitempty"` // HTTP-date the deprecated method is removed, set by the sunset option
+}
+
+// HTTPBinding is a route the method is served with, an HTTP verb and a path template
+type HTTPBinding struct {
+	HttpMtd    string          `json:"httpMethod"`
+	Path       string          `json:"path"`
+	PathParams []*MessageField `json:"pathParams,omitempty"`
+}
+
+// PathParts splits the path template into literal texts and parameters
+func (b *HTTPBinding) PathParts() []*PathPart {
+	return pathParts(b.Path, b.PathParams)
+}
+
+// HasBody returns if the request is sent as the JSON body
+func (b *HTTPBinding) HasBody() bool {
+	return HasHTTPBody(b.HttpMtd)
+}
+
+// PathPart is a literal text or a parameter of a path template
+type PathPart struct {
+	Text  string        // literal text, empty for parameters
+	Param *MessageField // input field bound from the path, nil for literal texts
+}
+
+// PathParts splits the path template into literal texts and parameters
+func (m *Method) PathParts() []*PathPart {
+	return pathParts(m.Path, m.PathParams)
+}
+
+func pathParts(path string, params []*MessageField) []*PathPart {
+	var parts []*PathPart
+	for _, param := range params {
+		pos := strings.Index(path, "{"+param.Name+"}")
+		if pos < 0 {
+			continue
+		}
+		if pos > 0 {
+			parts = append(parts, &PathPart{Text: path[:pos]})
+		}
+		parts = append(parts, &PathPart{Param: param})
+		path = path[pos+len(param.Name)+2:]
+	}
+	if path != "" {
+		parts = append(parts, &PathPart{Text: path})
+	}
+	return parts
+}
+
+// HasBody returns if the request is sent as the JSON body, GET, HEAD and DELETE requests send it as query parameters
+func (m *Method) HasBody() bool {
+	return HasHTTPBody(m.HttpMtd)
+}
+
+// HasHTTPBody returns if the requests of the HTTP verb carry the input message in the body
+func HasHTTPBody(verb string) bool {
+	return verb != HTTPGet && verb != HTTPHead && verb != HTTPDelete
 }
 
 type ServiceData struct {
-	Name            string
-	Comment         string
-	Methods         []*Method
-	Options         OptionMap
-	CommonErrorType string
-	Service         *descriptor.ServiceDescriptorProto
+	File            string                             `json:"file"` // file where this service is defined
+	Name            string                             `json:"name"`
+	Comment         string                             `json:"comment"`
+	Comments        *Comments                          `json:"comments,omitempty"`
+	Methods         []*Method                          `json:"methods"`
+	Options         OptionMap                          `json:"options"`
+	CommonErrorType string                             `json:"commonErrorType"`
+	BasePath        string                             `json:"basePath"` // path prefix of the methods, set by the base_path option
+	Deprecated      bool                               `json:"deprecated,omitempty"`
+	Service         *descriptor.ServiceDescriptorProto `json:"-"`
+	Extensions      ExtensionMap                       `json:"extensions,omitempty"`
 }
 
 // Option is a structure represents the option declared in a proto file
 type OptionMap map[string]string
 
+// ExtensionMap holds the decoded custom options of a proto element, keyed by the full extension name
+// (ie "acme.owner"). The values are bool, int64, uint64, float64 or string for the scalar types,
+// the value name for enums, map[string]interface{} keyed by field name for messages and []interface{} for repeated options.
+type ExtensionMap map[string]interface{}
+
+// CodeGenerator generates the code of one output language.
+// A new instance is created for every Generate call, so implementations may keep per-run state.
 type CodeGenerator interface {
-	Init(request *plugin.CodeGeneratorRequest)
+	Init(request *GenerateReq) error
 	Gen(applicationName string, packageName string, services []*ServiceData, messages []*MessageData, enums []*EnumData, options OptionMap) (map[string]string, error)
 }
 
-// OutputMap the registra for output code type and its associated output plugin
-var OutputMap = make(map[string]CodeGenerator)
+// StreamingGenerator is implemented by the output plugins generating the server-streaming methods,
+// the other plugins fail on the services having some
+type StreamingGenerator interface {
+	SupportsServerStreaming() bool
+}
+
+// OutputMap the registra for output code type and the constructor of its associated output plugin
+var OutputMap = make(map[string]func() CodeGenerator)
+
+const (
+	// ExecLang is the output plugin running external generators
+	ExecLang = "exec"
+	// ExecLangPrefix selects an external generator executable, like exec:/path/to/generator
+	ExecLangPrefix = ExecLang + ":"
+)
+
+const (
+	// LintParam is the generator parameter checking the proto files instead of generating code
+	LintParam = "lint"
+	// LintFile is the file generated in lint mode, it lists the problems found one per line
+	LintFile = "protoapi.lint"
+)
+
+// GetOutputPlugin returns the constructor of the output plugin for lang,
+// exec:<path> langs use the exec plugin which reads the path from the lang parameter
+func GetOutputPlugin(lang string) (newGen func() CodeGenerator, ok bool) {
+	if strings.HasPrefix(lang, ExecLangPrefix) {
+		lang = ExecLang
+	}
+	newGen, ok = OutputMap[lang]
+	return
+}
+
+// Comments are the comments of an element as written in the proto file, the lines
+// are kept and their common indentation is removed. The Comment of the element is its
+// doc comment, the leading comment or else the trailing one.
+type Comments struct {
+	Leading  string   `json:"leading,omitempty"`  // comment right before the element
+	Trailing string   `json:"trailing,omitempty"` // comment after the element, on the same or the next line
+	Detached []string `json:"detached,omitempty"` // comments before the leading one, separated from it by blank lines
+}
 
-// CommentMap map comment to message/service/field
-// path => comment
-type CommentMap map[string]string
+// CommentMap maps the source code path of a message, service, field... to its comments,
+// the numbers of the path are separated by commas, ie "4,0,2,1" for field 1 of message 0
+type CommentMap map[string]*Comments
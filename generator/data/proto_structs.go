@@ -18,16 +18,16 @@ type GenerateReq struct {
 	PackageMap map[string]*ProtoFile
 	MessageMap map[string]*ProtoMessage
 	EnumMap    map[string]*ProtoEnum
+	Request    *plugin.CodeGeneratorRequest
+	Params     map[string]string // generator parameters, like lang=go
+	// custom options of the first file on the command line by full option name
+	FileExtensions ExtensionMap
 }
 
-var _req *GenerateReq
-
-func Setup(request *plugin.CodeGeneratorRequest) {
-	_req = NewGenerateReq(request)
-}
-
+// NewGenerateReq indexes the files, messages and enums of a code-gen request.
+// Each Generate call owns its GenerateReq, so concurrent runs do not share state.
 func NewGenerateReq(request *plugin.CodeGeneratorRequest) *GenerateReq {
-	result := &GenerateReq{}
+	result := &GenerateReq{Request: request}
 	result.Files = make(map[string]*ProtoFile)
 	result.PackageMap = make(map[string]*ProtoFile)
 	result.MessageMap = make(map[string]*ProtoMessage)
@@ -59,8 +59,8 @@ func NewGenerateReq(request *plugin.CodeGeneratorRequest) *GenerateReq {
 	return result
 }
 
-func GetProtoFile(filename string) (file *ProtoFile) {
-	file = _req.Files[filename]
+func (r *GenerateReq) GetProtoFile(filename string) (file *ProtoFile) {
+	file = r.Files[filename]
 
 	if file == nil {
 		log.Println("proto file not found: " + filename)
@@ -68,33 +68,37 @@ func GetProtoFile(filename string) (file *ProtoFile) {
 	return
 }
 
-func FlattenLocalPackage(msg *MessageData) {
-	_, p := GetMessageProtoAndFile(msg.Name)
+func (r *GenerateReq) FlattenLocalPackage(msg *MessageData) {
+	_, p := r.GetMessageProtoAndFile(msg.Name)
 	if p == nil || p.IsFileToGenerate {
 		msg.Name = msg.Name[strings.LastIndex(msg.Name, ".")+1:]
 	}
 
 	for _, f := range msg.Fields {
-		_, p = GetMessageProtoAndFile(f.DataType)
+		_, p = r.GetMessageProtoAndFile(f.DataType)
 		if p == nil || p.IsFileToGenerate {
 			f.DataType = f.DataType[strings.LastIndex(f.DataType, ".")+1:]
 		}
 	}
 }
 
-func GetMessageProtoAndFile(name string) (msg *ProtoMessage, file *ProtoFile) {
+func (r *GenerateReq) GetMessageProtoAndFile(name string) (msg *ProtoMessage, file *ProtoFile) {
 	var pkg string
 
-	msg = _req.MessageMap[name]
+	msg = r.MessageMap[name]
 	if msg == nil {
-		if !util.IsStrInSlice(name, []string{"string", "int", "int64", "bool"}) {
-			if _, ok := _req.EnumMap[name]; !ok {
+		if !IsScalarType(name) {
+			if _, ok := r.EnumMap[name]; !ok {
 				log.Println("msg not found: " + name)
 			}
 		}
+	} else if msg.File != nil {
+		// several files may share the same proto package, use the file defining the message
+		file = msg.File
+		return
 	}
 
-	file = GetFileFromPackageWithName(name)
+	file = r.GetFileFromPackageWithName(name)
 
 	if file == nil {
 		log.Println("pkg not found: " + pkg)
@@ -102,15 +106,25 @@ func GetMessageProtoAndFile(name string) (msg *ProtoMessage, file *ProtoFile) {
 	return
 }
 
-func GetEnumProtoAndFile(name string) (e *ProtoEnum, file *ProtoFile) {
+// GetMessage returns the message with the given full name, nil if not found
+func (r *GenerateReq) GetMessage(name string) *ProtoMessage {
+	return r.MessageMap[name]
+}
+
+func (r *GenerateReq) GetEnumProtoAndFile(name string) (e *ProtoEnum, file *ProtoFile) {
 	var pkg string
 
-	e = _req.EnumMap[name]
+	e = r.EnumMap[name]
 	if e == nil {
 		return
 	}
 
-	file = GetFileFromPackageWithName(name)
+	if e.File != nil {
+		file = e.File
+		return
+	}
+
+	file = r.GetFileFromPackageWithName(name)
 
 	if file == nil {
 		log.Println("pkg not found: " + pkg)
@@ -118,12 +132,12 @@ func GetEnumProtoAndFile(name string) (e *ProtoEnum, file *ProtoFile) {
 	return
 }
 
-func GetFileFromPackageWithName(name string) (file *ProtoFile) {
+func (r *GenerateReq) GetFileFromPackageWithName(name string) (file *ProtoFile) {
 	slices := strings.Split(name, ".")
 	temp := ""
 	for _, s := range slices {
 		temp = temp + "." + s
-		if file, found := _req.PackageMap[temp[1:]]; found {
+		if file, found := r.PackageMap[temp[1:]]; found {
 			return file
 		}
 	}
@@ -147,6 +161,7 @@ func NewProtoFile(proto *descriptor.FileDescriptorProto) *ProtoFile {
 	}
 
 	p.Messages = make(map[string]*ProtoMessage)
+	p.Enums = make(map[string]*ProtoEnum)
 
 	initMessages(p, "", proto.GetMessageType())
 
@@ -155,9 +170,10 @@ func NewProtoFile(proto *descriptor.FileDescriptorProto) *ProtoFile {
 		p.Services[svr.GetName()] = NewProtoService(svr)
 	}
 
-	p.Enums = make(map[string]*ProtoEnum)
 	for _, obj := range proto.EnumType {
-		p.Enums[obj.GetName()] = NewProtoEnum(obj)
+		e := NewProtoEnum(obj)
+		e.File = p
+		p.Enums[obj.GetName()] = e
 	}
 
 	return p
@@ -166,7 +182,14 @@ func NewProtoFile(proto *descriptor.FileDescriptorProto) *ProtoFile {
 func initMessages(p *ProtoFile, namePrefix string, msgs []*descriptor.DescriptorProto) {
 	for _, msg := range msgs {
 		name := namePrefix + msg.GetName()
-		p.Messages[name] = NewProtoMessage(msg)
+		m := NewProtoMessage(msg)
+		m.File = p
+		p.Messages[name] = m
+		for _, e := range msg.GetEnumType() {
+			nestedEnum := NewProtoEnum(e)
+			nestedEnum.File = p
+			p.Enums[name+"."+e.GetName()] = nestedEnum
+		}
 		nested := msg.GetNestedType()
 		if nested != nil {
 			initMessages(p, name+".", nested)
@@ -181,6 +204,7 @@ type ProtoOption struct {
 // ProtoEnum is a thin wrapper around descriptor.EnumDescriptorProto
 type ProtoEnum struct {
 	Proto *descriptor.EnumDescriptorProto
+	File  *ProtoFile
 }
 
 // NewProtoEnum create ProtoEnum from descriptor.EnumDescriptorProto
@@ -193,6 +217,7 @@ func NewProtoEnum(proto *descriptor.EnumDescriptorProto) *ProtoEnum {
 // ProtoMessage is a thin wrapper around descriptor.DescriptorProto (Message descriptor)
 type ProtoMessage struct {
 	Proto   *descriptor.DescriptorProto
+	File    *ProtoFile
 	Options map[string]*ProtoOption
 	Fields  map[string]*ProtoField
 }
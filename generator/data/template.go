@@ -0,0 +1,176 @@
+package data
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/yoozoo/protoapi/generator/data/tpl"
+)
+
+const (
+	// TemplateRoot is the path of the embedded templates
+	TemplateRoot = "/generator/template"
+	// TemplateDirParam is the generator parameter naming a directory of user templates,
+	// a file there overrides the embedded template of the same path relative to TemplateRoot
+	TemplateDirParam = "template_dir"
+)
+
+var debugTpl = os.Getenv("debugTpl") == "true"
+
+// TemplateFuncs are the funcs available to all the templates, the funcs of the
+// output plugins take precedence over them. The string to work on comes last,
+// so they can be used in pipelines like {{.Name | trimPrefix "Get" | snake}}
+var TemplateFuncs = template.FuncMap{
+	"lower":  strings.ToLower,
+	"upper":  strings.ToUpper,
+	"title":  strings.Title,
+	"camel":  LowerCamelCase,
+	"pascal": UpperCamelCase,
+	"snake":  SnakeCase,
+	"hasPrefix": func(prefix, s string) bool {
+		return strings.HasPrefix(s, prefix)
+	},
+	"hasSuffix": func(suffix, s string) bool {
+		return strings.HasSuffix(s, suffix)
+	},
+	"trimPrefix": func(prefix, s string) string {
+		return strings.TrimPrefix(s, prefix)
+	},
+	"trimSuffix": func(suffix, s string) string {
+		return strings.TrimSuffix(s, suffix)
+	},
+	"contains": func(substr, s string) bool {
+		return strings.Contains(s, substr)
+	},
+	"replace": func(old, new, s string) string {
+		return strings.Replace(s, old, new, -1)
+	},
+	"split": func(sep, s string) []string {
+		return strings.Split(s, sep)
+	},
+	"join": func(sep string, a []string) string {
+		return strings.Join(a, sep)
+	},
+	"lineComment":  LineComment,
+	"blockComment": BlockComment,
+}
+
+// LoadTpl is the function to load template file as string
+// It loads file content from esc embed by default, a template of the same path
+// in the template_dir parameter overrides it.
+// Set environment variable debugTpl to "true" to load template from disk directly
+func (r *GenerateReq) LoadTpl(tplPath string) (string, error) {
+	if dir := r.Params[TemplateDirParam]; dir != "" {
+		file := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(tplPath, TemplateRoot)))
+		content, err := ioutil.ReadFile(file)
+		if err == nil {
+			return string(content), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+
+	//useLocal is true, the filesystem's contents are instead used.
+	return tpl.FSString(debugTpl, tplPath)
+}
+
+// NewTemplate loads and parses the template of the given path with TemplateFuncs and funcs
+func (r *GenerateReq) NewTemplate(name string, tplPath string, funcs template.FuncMap) (*template.Template, error) {
+	content, err := r.LoadTpl(tplPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return template.New(name).Funcs(TemplateFuncs).Funcs(funcs).Parse(content)
+}
+
+// LineComment formats a multi-line comment as line comments starting with prefix, like "//".
+// The lines after the first one are indented by indent, the template indents the first one.
+func LineComment(indent, prefix, comment string) string {
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		if line != "" {
+			line = " " + line
+		}
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n"+indent)
+}
+
+// BlockComment formats a multi-line comment and tags like "@deprecated" as a /** */ doc comment,
+// on one line if it fits. The lines after the first one are indented by indent, the template
+// indents the first one. It is empty if there is no comment and no tag.
+func BlockComment(indent, comment string, tags ...string) string {
+	var lines []string
+	if comment != "" {
+		lines = strings.Split(comment, "\n")
+		if len(tags) > 0 {
+			lines = append(lines, "")
+		}
+	}
+	lines = append(lines, tags...)
+	for i, line := range lines {
+		// */ would end the comment
+		lines[i] = strings.Replace(line, "*/", "*\\/", -1)
+	}
+	switch len(lines) {
+	case 0:
+		return ""
+	case 1:
+		return "/** " + lines[0] + " */"
+	}
+	result := "/**"
+	for _, line := range lines {
+		result += "\n" + indent + " *"
+		if line != "" {
+			result += " " + line
+		}
+	}
+	return result + "\n" + indent + " */"
+}
+
+// LowerCamelCase converts snake_case names to lowerCamelCase, like protoc does for json_name
+func LowerCamelCase(name string) string {
+	var result []rune
+	upper := false
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+		} else if upper {
+			result = append(result, unicode.ToUpper(c))
+			upper = false
+		} else {
+			result = append(result, c)
+		}
+	}
+	return string(result)
+}
+
+// UpperCamelCase converts snake_case names to UpperCamelCase
+func UpperCamelCase(name string) string {
+	name = LowerCamelCase(name)
+	for _, c := range name {
+		return string(unicode.ToUpper(c)) + name[len(string(c)):]
+	}
+	return name
+}
+
+// SnakeCase converts camelCase names to snake_case
+func SnakeCase(name string) string {
+	var result []rune
+	for i, c := range name {
+		if unicode.IsUpper(c) {
+			if i > 0 && result[len(result)-1] != '_' {
+				result = append(result, '_')
+			}
+			c = unicode.ToLower(c)
+		}
+		result = append(result, c)
+	}
+	return string(result)
+}
@@ -212,378 +212,546 @@ var _escData = map[string]*_escFile{
 	"/generator/template/echo_enum.gogo": {
 		name:    "echo_enum.gogo",
 		local:   "generator/template/echo_enum.gogo",
-		size:    491,
+		size:    856,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/3SQMWvDMBSEZ79fcYQMEjTOnuKpaSFLUmjoEjIo9qsxtSUjy0MQ+u9FtuNQSLZD3H36
-eOs13kzBKFmzVY4LXK5orXFGtdUrtgfsD0e8b3fHlKhV+a8qGd6nn2MMgchd2+FprxoOAZV2RLnRnYOg
-xPsVrNIlI/2ouC46hBBfb23vl7eYRci3qnseKyuwLkIgSfTT6xwij6LzVOLL2UqXQqIbAjwlWjXcYZOh
-Ue1prp7Hgqfkic9daIPFnBcv02D0SAJRYtn1VmP45xSFzhSe+cXDChkPEtWmpai0k0NVUqCHOv9xy5m3
-62a4kLgYU8MTAEzoYZBld4XpA9ZFxP4FAAD//9bZDJPrAQAA
+H4sIAAAAAAAC/4SSMW/bMBCFZ96veBAySEBL7i48xW2RJSnQoEuagZHODlGJFCS6RUDwvxekXZlOHWSj
+jvfeve9EpXDtOsaOLU/ac4enF4yT806P5hM2d7i9u8fnzc29JBp1+0vvGCHIb4djjBTCH+OfIa/dMLD1
+udIby8dvVBUqpSrIfPMRbLvjyWwhNzxO3KbBRfFkpVShUQqn9hX8M4PtfoCZ0S11GJtvMgO2pmdZWPiX
+Mce/1QPHCGM9Uevs7FGTSG2TtjuG/GK472bEeKi+JhSvEH/6AlKcxomLmOJ/TqHUme4C6m/d7/l91vPp
+8qs7ooZw9Y96nTbwI9mdp22Itnvbom5dV2ypwXc/GburG8z5gEDC6oFnrNYY9PiwtD4eGgKJN5ZZRlqh
+WoTVBxJFEhGJxMR+P1nkSQ8p0iPFtxKmN1w36X8iLMraWN/k1oYiXQx0bne1+N3Mi3nd4Mm5HoEA4Gid
+Bes1Chxanncy/jsAxVKjO1gDAAA=
 `,
 	},
 
 	"/generator/template/echo_service.gogo": {
 		name:    "echo_service.gogo",
 		local:   "generator/template/echo_service.gogo",
-		size:    2781,
+		size:    3083,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7xWb2/bthN+LX2Kq3/9taRjy4q3IksCI8tiu8uA/MFi7MXCNJOls01EJg2KSp3K7Gcf
-TrI9O033L8HeCNTd8bnnHh4PbLXgRCcIY1RoIosJDB9gZrTV0UweQvcCzi8G0OueDgLfn0XxXTRGKIrg
-slo65/tyOtPGAvO9msExzmc13/dqY2kn+TCI9bSVRsPMRvFdC+OJrm37HrT+pHVrlXG9GOuaz30/1ior
-oXvTSKaQWSPVGDpQ+8AYY9dR89Nx89ebhRDJ4vrV/4R4/f83b4WoC7EjRFOIVkeIIyE+3P5WCLFwn28W
-10LMizA8Dl2TVt29ft/Ror8fLk397snS1O2vTP1e393wHSZE8J8n5XW+YEyIebvNqWhahQsh5uE+r9Nf
-mNAn4vxoy7fDjxijzOEu4YXf0WdIn5g+SMbdkRDzvRHVMm/vljzb35Dj3bBi/S6hvz18Xg0LJsQmlf1t
-KlWO0fNycM7r/1CdSlPOv/+ilZ5V68thbSM1F0IEi9vF52dh1l+OHudCBHxno+CXEu4lRXtBwZ4t1tHr
-cqrdR4ZmmplXU60D1dwMzvLMnujpTKbIShen6FaLBu55NEXnQGZgJwhSWTSjKEaItbKRVBlEaVq6yGB0
-mqLJfPsww83N612F7xVFE0ykxgjBGdqJTjJwjszBQNoUnWM0roMTrSzObQPqRRGcqlluBw8zdI4DI8tF
-btemopAjUAhBzxhtyAa1mnPV1rWN4lAlzvGKA6qEEjvff5rRKFcx3K6LuP0xUkmKhmXmHori9dLMoWS7
-dPZpT+F7Bm1uFBAEi2GzHg4MjQEkVpxCPangoAMKP7JHhfrkHFEodCAOfpAqYVLxw9LyqgNKpiWAZzCb
-EcaJnk61KgsuKLpcHcCb9bo4wyyLxnhAEJUyjJP43opxHPx0dXHOvm2HDSBY7nueWxK5j9KeMZRIquCX
-KJVJZJHxw5XjryittixpVbv+VnKd2yfPGOiQSymXR0spM3MfbDRT3ACpCIlO+WmMtc76cRFbtMJwmazi
-5a26yJUct4PbFKxzy32PWmyj3+ha/YxjmVk0W9crzzABq2EoVQJG55YuUtmEX4QzhHrZVL14ohtQdeS6
-IQvfa7Ug+yhtPCFAerzEFojX8eUpNQOaBkmRZ/SoIaC3GXRxFOWprdw+CXLbAH1HimJQWQNWZd0K5YcU
-RXqtwqDq5j9eVMFW6lKSPxkD61O6QnMvY6zO6fLialCdFQbvewNWK9+CduJcrfGVW8q3L/rXsd/31tCU
-5l9gPxoovwcAAP//F5mGs90KAAA=
+H4sIAAAAAAAC/5RWTW/jNhA9i79iVghSKXCkYLGnBDm0iRdJ23xgHbSHtggYaWwTkUmDpJymAv97MaQk
+y4mcdm8yOR9vZt48M8/hQpUIC5SoucUSnl5hrZVVfC3O4PIObu8eYHp5/ZAxtubFM18gNE12Hz6dY0ys
+1kpbSFgUL4Rd1k9ZoVZ5xZ+M5cVzjsVSxbt3r0r9o1Tepek/FipmKWNNI+aQXajVCqV1rmkqIbH9CXEM
+cZ7HO/dYGXQuzwnYLV+hcyAM2CWCkBb1nBcIhZKWC2mAV5W/ogOtqgq1aRqUpXOsaY6BUl/iWmNB3XCO
+5TnLc9genXpvg3ojCqQ8ZX8FQvpLXw/MRYWZjxmi29c1DhH20BoWkZXmcoGQ3aBdqtKAc+H4RdjloFoW
+venHn7brSOcR0kWj1fSng4B5vuP3ptqmyR6ErdqmflzsWPoZ6g3qmdXIV0IuwlUXMiF2ZBdKWvzbTuCo
+abJfVcGra7mu7cPrGp3zpwdt17aeIWDquSIRsqnWSpMHxLFzPlJ/RH4DVIEu3wkjhaQ/vqttf74HwGQP
+gvRdj1CWNGvH2DgJ9veRecbvaw0YlGXYAo1mraRBA2resxf1semCwcrnG7CTGwgJj2fEsukGpTUdhfen
+tLouLBHaGISj7V5ns9k02LCAe0ZlB4S8xwdWhdWsBErL5rUsIDEfzt8HSlRtYXQ6KSCNgBBptLWWYDJj
+MOu8UuYGGzoYx55heEiPfZser7gsK9SJ0ZtBX1LwfGovv5LPFgCFSAoYMi6FBLUOUFMy7ZaHyxIO+gUW
+Sl4hL1EbSJQeXGA53PKUmBUNe4/2fYikmEDcNNmslgatc3Hapm2pGQkJp+cg8SUZ24ZWpO+5XX4VWJXG
++1AV51BkPwlZJkJSSDEHf3oOUlS+ttZqAJDMKdI913zlka34+g9jtZCLv3qdbJz3Hmrlm/RRFPejiU/h
+UMhsS5hJ79xVGNE2Rm4L8lMHcqgSfQWDus5GzLugRGbqHAmskl4BGvLzX6dw2H83N2gMX+ApBQtSkaRd
+DE+VIvt5dnebfPl8MvE7EuBu6SGVheza/I5V9YtUL9IPyHkLMYcNr6ZaExQhs994JUpuMUnPuotPg5GM
+ge5cWuDB67/hDXu8hTomX1EUBIgSH+7f8WbAlFt86ZUkKdKQbUSAwbkwNuUb0Iqv0ZsBIYhnQk4ggEgH
+bX0f7KyNtduzICje3atKmOGXk5NJu8tjDXnvdlEpg0m/f4F3LIpUbfcV1yZo66ISx2r7uKae2uN1ddP9
+X9W0xp/J2MvqzqY5tquteQ7fcCGMRb3zYqsNlmAVPAlZgla1RW2C5L4zTxCOvIROi6WaQNDfXn4b/4ox
+L8IWSwpIUlJYIIw/3l/TBqKeUFtqQ/9+FOgHA5c453VlwzWj5jxOQD1TdzELp1kSsu6YpmdkRb3rzCAI
+54C4O6lT5tf4g0ffgV+KrP/dmn2jngQrpGlfWbu+saVzSezf5HbpXDyhP6iD1chfVJruPDnePD/+HQCj
+VEymCwwAAA==
 `,
 	},
 
 	"/generator/template/echo_struct.gogo": {
 		name:    "echo_struct.gogo",
 		local:   "generator/template/echo_struct.gogo",
-		size:    924,
+		size:    6233,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7SSUY+TQBDHn7ufYiSmgeaO+oypyeWghqTX01r7YszdWqZ1lS7csDXWzX53M1s4qNFH
-eSDDf2bn/5tZplO4rQqEPWokabCALyeoqTKVrNVrSO9heb+GLM3XsRC13H6XewRr43fn0DkhplMWbkvZ
-NEt5YMmcavxDg8bQcWvAipG110BS7xHiucKyaMA5VuO1MiWXcniqOXr81lQ6CayNz12Cx/Nx1AUfcuKv
-vcTuqLcQEkysfTmEiOAtmt4ojAZWVgAAqB0QzGagVdkq/PyQBL+Qqo0s+xPPWUJzJN0VePmcbBMU95Yt
-csv/DHq5qwg2slSFNBhGMOnijKgi3h8SNZDM4NPniZ/ZJ6z792Kveay467PCp6Mi9AAjnneAx6MHAZuM
-kC3mebZIH1bZ+4/5KktZZe8ZyLpGXYT8dQXjAYUPeYrkou8V+DQvLoExukiM3MVNdpT41IPOKzpIAwEe
-pCqDDvcF/cxYiO+k2X79YEjpfTj0inr8fLm5WeTpQ3Z3ky/+N30Xqx2UqH33CN7AK4/T/grji8u0/t0k
-wLXON23rtCqFE78DAAD//3AqAjycAwAA
+H4sIAAAAAAAC/7RYbW/ayhL+bP+KqRVFdkTNle43enOlCEjLOQHahESVciK6gQF84reulwSO5f9+tC+2
+14BN06b90Nj7MvPM7DzPjmm3oRvNEZYYIiUM5/C4hZhGLCKx9wF6YxiNJ9DvDSauacZk9kSWCGnqfpaP
+WWamqTsI4oiyJMtMM029BbjdKAgwZFmWpr4XonoFywKr3bYq8+gnmGXtNjfa9UmSjEiAYiKcC+vvgVvs
+YUxxxgFmmdlum+02lEMdYCuEAJOEo/MSmBdT4IViUoQEC89HV9iU1tk2xh3HkDC6njFITYOvoyRcIriX
+HvrzBLJMjr54bKVFYRo7cf7F8kjzHdKfcTCcYlQz2G5X9h0Id8EhHQ+26t2deMznUfLHbcyfvv2dRGHH
+SlP3j5vxaEKWWWZ9K7YVIatEjEOMFm+SiAKKl6TpiX4EU21SoXu/AykzNUzl4ZiLdTgDm8LZjkkHPiIr
+zdqOloDUBACefwrn5xB6vhrh/54JhX+QRnfEL3cUsxTZmob5AjGcmdoEdUuXCrLCX6I/iaBzrueVl/ah
+lJxEWsbAC2IfeY4lY2X9B49IE4gWEHFrIPbI/bLSj5kNGdIFmSEv/ua1tmNWg9APQZC59kRXEV+XpgrZ
+YbivoP0P8mCP9LUAqwLwFhVeVk5Romf1ABw4lntIK8V0rOorW5ttN5JBVXXo1ZR6aaahOH6JoZsWRE+c
+L9Tdi8xtzOkHvnEvlI1O0DKmetbvMH6P09ojL99PJOmuExYFXFpzbgwJTVbEF0Mv1GOYwHxNCfOiMAES
+zkXpJsgUoTlBkMxWiiVLGq1jXuCiuP8LwswiokGR2ep95uj+bAfs+4fHLcMWIKURdXiZt9slkYR8xD7x
+QnhCjPmERyGic6QtsWofqsSl1EdaI8slzsF6bwmgwhqhKKJlGAJZMKR8b2Aago1ySRW4afBjQEolUtNQ
++DrnGkMNsdM0au7q4mYdJD2FOx8vKp5fMO41eRmq9qG8D//EbZa1osBjGMRsa31TBvNrcf+lRtKrkzsA
+3xpIloqcdGRSbepkTZ2MtxDnqCfIHcf8L/HFIi4FGkvgXa4KBp+SZ6LNi7qC86KFXEauqr/cvq2bcz6I
+9ZpRo1QZYcs0DB6UklY/wUPn+VuQ7AHJ9rui/Q5p//CTF4/NVrCRuqWLlmvz6neqvWa1QGYkQWgQto5p
+GLWhp2k1V1lWn4y8ExdVqOZUE27rMulUA5fJf2Xm1LzuypZBOKbUyNsw0FSSIpkfEsmfFMizXYWseLMf
+QUqkI4WHR9QgUir6zrkMpzBlP7bAPhN7HJs6teWV56dUt4A8oR2Q+D5h1AuXDzu64DT7PFWJPOavURIO
+8Os5v3ql/ftCl6wHdbcaGi6tzgp0Be2eW3D6YyKwx/9XUq9KNxAO6rn2A1GenoI8FfvZ4YCtcO37lsAs
++H1az9Q0q2SokZt7Oauws5jN+ckTunldQvdTs2nMstb7SYZ+/fp1yumi8ifnEyDCZRyJbwnOTM5TQZ+c
+pSKzRSvwuNX7kxZ/5w/whNuyU66yterZdkDjSvEJk2Y8boX68IL09W1DWQ4dCUy2ho4der7T+g3twc87
+3PngLFrSz4QxpGHphHdZdkUP9pYYdLNT12WtwTlQXOImdofrhHWjIPZ8tNPU/bKOGM6Vrd17wzHzH3jq
+utY74ntzwtB24Cx/7ud6jJQKqbx/OBMoxUR6VNWUnfw4+fDJsziMAzPeAk6e3Wv8vvYoznOBSFO3z3uw
+7gpnT+LTRPRwF3GMoYQB1uWgf9WbXve/3A6u+z0rO6xf0v7QCwcMg0KAfNxpS+B//Fu1XFjjczC6u7ga
+9KaDSX847Y5vR5MjfsnmiN//K79k85Z+P5HkjvhrvF77uHc4a7yZRTGqYW1EOq/NoHY4Yk+WaVmrAT6+
+nUzHl9Pri9HH/tFUHXBQpufXHXjhFYZLttLcyIFKIMVQ0zlc9UcfJ5+OBlTvr4zrzfwpDci9vWtQE3dI
+2Gx1I6/YMtvOQQyfLyaT/vVoOhzcDC8m3SYU+J0DuYxoQBhYGBDPtwo82sU7SPp87qjrPPz+8GJw1Rx9
+DxdeiPNx6G91EQnXgQye/85iWQe93I56/cvBqN+b9ke3w+ndxdVtUyntUuhI03ToWSkB11euAP/RO8fT
+igyn4v+kA3ytdFbpEf4dAL8lOgZZGAAA
 `,
 	},
 
 	"/generator/template/go/enum.gogo": {
 		name:    "enum.gogo",
 		local:   "generator/template/go/enum.gogo",
-		size:    494,
+		size:    859,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/3SQsWrzMBSFZ9+nOIQMEvxxdv94alrIkhQauoQMin1rTG3JyPIQhN69yHYcCs12EOd8
-+rjbLV5MyahYs1WOS1xv6KxxRnV1Vpn/2B1xOJ7wutufUqJOFd+qYnifvk8xBCJ368ang2o5BNTaERVG
-9w6CEu83sEpXjPSt5qbsEUJ8vbe9X99jHiGfqhl4qmzAugyBJNHXoAuIIrouU4kPZ2tdCYl+DPCUaNVy
-jyxHq7rzUr1MBU/JE5+HUIbVklf/5sHkkQSixLIbrMb4zzkKXSg884u3FTIeJKrNS1FrJ8eqpEB/6vzG
-rRfevl/gQuJqTANPADCjx0GePxTmD1iXEfsTAAD///3pkaPuAQAA
+H4sIAAAAAAAC/4SSMW/bMBCFZ96veBAySEAr7i48xW2RJSnQoEuagZHOClGJFCS6RUDwvxekXZlKHWSj
+jvfeve9EKXFtW0bHhifluMXTC8bJOqtGvensJ+zucHt3j8+7m/uaaFTNL9UxvK+/HY8hkPd/tHtGfW2H
+gY1LlV4bPn2jKFBIWaBONx/Bpj2d9B71jseJmzg7K56tpMw0UuLcvoF7ZrA5DNAz2qUObdJNwsBe91xn
+Fu5lTPFv1cAhQBtH1FgzO5QkYtukTMeov2ju2xkhHKuvCcUrxJ8ugxTnceIipvifU0i50l1A/a36A7/P
+up5ef7UnVO+v/lFv4wZ+RLt12opofzANysa22ZYqfHeTNl1ZYU4HeBJGDTxjs8Wgxoel9fHY4Em8scw8
+0gbFIiw+kMiSiEAkJnaHySBNeoiRHim8lTA+47KK/xN+UZbauCq1VhToYqC13dXidzMv5mWFJ2t7eAKA
+k3USbLfIcGh53tH47wAzDIWTWwMAAA==
 `,
 	},
 
 	"/generator/template/go/service.gogo": {
 		name:    "service.gogo",
 		local:   "generator/template/go/service.gogo",
-		size:    3138,
+		size:    4877,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7RWTW/jNhM+m79iXiN4K6VeKVi0Fwc+bBM3mwKbGBujPQaMPLaIyKRCUnGyAv97MZRk
-S7KdzaLtTRrOxzPzzAwZx3ChFggrlKi5xQU8vEKulVU8F+OVOofLW7i5ncP08noeMZbz5JGvEMoymlWf
-zrGyjK7XudLW+J8TA+MJRM4x4aUQsMFwJWxaPESJWscZfzCWJ48xJqkads9elfqmVNwg2H6s1JCFjMUx
-Rb7ha3QOhAGbIghpUS95gpAoabmQBniW+SMSaJVlqA2zrzm2jbdWJRuU5QcQS4g+FTb9ik+F0LhwjuS1
-Oh0ECRDg6EJJiy82hAC1BtRa6bBygbK2+gCayxVC9AVtqhYGnGPe21zYDJ3ruRqBxifCdi3zwl6p+WuO
-zoUQaDQ5yW8L2zooS7EEiRBNKTbJYDh0bgQP4psXkYn/2Fl4aCM4gJiwOcaOlGBZyATuO3W4/8zlIkMd
-GP28K2hYZfRFLBYZbrjG38myZAONttASyFEg8cVWerUPUgr3JGTWsXuj8l53QP8TMPo56lEWMjoWS5/5
-/yYgRVZZNJyfmOgzNxdqvVbS14wYHAwGcQw4npBZFFBLRx2VEDYiyyDnUiTkhRuD2golYclFNoJNKpKU
-GlQqC5uUW9ggbLi03jfBGYF6hDcCnNN5hbQpRRL9cXd7E/zy8WwEGPojt02l7r229p3VQq6CX8/OPPFV
-SwSht3S+LrUq0UKl8v6oG3YOy3K/k3s90euHk25D9Gj9EVZpKMYTkLgJurNRB6AUamYnkES/CbkIND6F
-53tkH+CatFt8+0kbT6DPxEz5ReFcuTUYw/+332Wrro2jPa7Id9jAwMzgIc2GpZ1iTahj8SkjUS+BP3km
-FtziLgmxhGeeTbWmRDQ+RY1KEJ43J+2qfD/nToxx7eN7aTqPtoJ/GrMqzsGdBe2l1dlR4+0wbzemX5Ih
-OzjMb8/yfzjKPzjJRwfZ9Vl/1xT7Ia5zP1DbqlbbS6FLfQcI+W70dhQ2aFhf/+PZju7WuvC3XBzDV1wJ
-Y1F3burC4AKsggchF6BVYelO9ptkTz1AOPWbYZqkagS9a6Zkgz2Lv4RNZxqX4iVAbzCC4TBkR+DstI8B
-g42wKSSFsWoNuVc9grUd+TjqUe0EjCfTJxHHYDbCJikFJ3ligar7aXZN6wX1iGgtjJArvyd/MnCJS15k
-tjpmRO79tvmiShoFFYiO6q4FGzWo1urudRV1Qntejz+LVlXEK62KPKgz+xmGZRndoX4WCc64TZ0bjt54
-OYSdN9OxR1O7u2vfVX/Pbu/mw7bGidmHGV1N5wHBqjweQHUQUb2h0Zt30nuvh2oW2t/HM7maviMRSvef
-ZOLt/6VUeg/HvwMAAP//TNNGskIMAAA=
+H4sIAAAAAAAC/9xYX2/bNhB/Nj/FTTA6KVOloNheHPihS9IlA5oEdbA9bEPBSGebqEw6JB03FfjdB5L6
+bytdMexlb7Z4f35397vjSWkK5yJHWCFHSTXm8PAMWym0oFs2W4kzuLiFm9t7uLy4vk8I2dLsE10hlGVy
+538aQ8oyud5shdTK/ZkqmM0hMYYw9xRCMglWTK93D0kmNmlBH5Sm2acUs7UI+mfPQnwRIq0RND9WIiAR
+IWXJlpCci80GuTamLAvGsfoLQQBBmga9cywUGpOmFvAN3aAxwBToNQLjGuWSZgiZ4JoyroAWhTuyD6Qo
+CpSqLJHnLqrXYF1f4FZiZhNlDElTkqbQPpo5bYXyiWVo/eTNETDuDl08sGQFJs6mt66ft9hF2EAryaT2
+/Han1x/wccek8z1pxO1BmIFNZnIuuMbPOoIQpQSUUsiITFpH7qekfIUwZTFMN65S71GvRa6gFmBLEBKm
+DKZHvHYM7Zled7JNJoN6/KnritQaHeWDbDZPOwbTtKc3yHZZJvdMF1VRX072MfcLlE8oF1oi3TC+qrJa
+mRykNAaJj9bjNd/u9C/i/nmLxsSgnDaclOW0qkdrwluOIHS05QjJpa2IVYUgMOaBfXEPrFn3ozVb5fmg
+io7O3wwzglCi2trntzvdORgBFsMotApYDC8QDHluuWRIy7Yux8bTT1yjjiUSFPLcN6+NRnCFCsSyaTqU
+r1VtDDbOX6epqALv8PXCkvPyCblWdeeNu9Ryl2nbh0ohnLTjKFksLr0M8bgXNmqPkDb4QAs/UQqGXJPl
+jmcQqpfZYg2NlCvyGbdwJOqd5KASpTBpVKIq6e3QqmpBRsaIQ/SxN0s+XlGeFyhDJZ/a/EWeZu9Znhe4
+pxLfWc0WiDUUcvysvVxlwwpFB0+sWk/vhenlZCf2/xyUfEoGYy8i9pgtrTR8NwfOCq9RN/lUJVdU2ZEi
+uCOyJelkYkcJzuZWLQntlZX0RCLYs6KALeUss1aoUig1ExyWlBUx7NcsWwNTwIWG/Zpq2CPsKddkUsGJ
+QXyCFxyc2XOPtE5Flvy6uL0Jf3xzGgNG7sg0oVTt1ZVeaMn4Kvzp9NR1o+/TMHKahpBW1JbFpsrZMwOK
+HPbngBMDPkz7hBiU9VuqWlWI8txWqR7sTPArpDlKBaGQ3RPMuzdG5NLRbUjUhzbCLIagLJPFjivUxgQR
+6efTjsvZHDjuw/7UrKKsVo47qtfvGBa5clqej1nyM3ON9xgRX3X7uENCL9bBaOWtqTsq6caB29DtH8oV
+8q/m2i9NS+GqPAP/k0nQ1CeYwSuJj0k7SeJD1piq+get0r1VmhC6kZ0dka+tHukxq9fpMzfHZnMYdsCd
+cNEaUzYKM3jlpHz6IWgOAmPKDr9rwwc94wdgHXkd00Cy7pZWsArGkPTEcWMQ0G+0YDnV2AbFlvBEi0sp
+bWA287VIGJ3VJ91R9PUc9HzMKhtfC9M4tB7+Sdr207G1ZlKtKbO5S/LI3VN2mHqD++aGC7PI1KkZLgvQ
+rjEurz5Qn33PpQpjNbybtcVtKvX+FJGjQ/zlGf4fjvBvnOD1ZexicTey52pnlJsh30aVjs1zN86rbByp
+gM9eXYcB+UagWS+1RkunGh85pnleCIVhM0HrDrOEHONG66O3OM7mcJQP/w8eHF7ko9V/8Rb/11WvgfzT
+Wlfyb047Y6aDurM6QLWtf8AVUxpl7/V6pzAHLeCB8Ryk2GmUym8VB+IhwonbEi6ztYhhsHKWZHKg8TvT
+6zuJS/Y5RKcQQxBEZAROKz0GzL/FZjulxQa2TnQEa9fzOOq4MgL+VndBpCmoPdPZ2jq3zzMNNtNv767t
+LYcytiXeKfviYu1+r+ACl3RXaH9MbKE/NkRM/NMk9CB6oi0dazHw201ntvdcR8TxbOwzA93ptXU6/o7Q
+e//rfmE4+K5QfW6A/oeI5IMthJdCOxKutN6+17kxYZXJH9wKZ1cgY4LYQplujiynUX1392OIwcZQjZ+o
+94baf1slfw8AqDN2Ag0TAAA=
 `,
 	},
 
 	"/generator/template/go/struct.gogo": {
 		name:    "struct.gogo",
 		local:   "generator/template/go/struct.gogo",
-		size:    1068,
+		size:    6406,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7RTQW/bPAw917+Cn/GhsIPW2dlDBhSJMxhI0y3NchmGVosZT5stubQyLBP03wcqduMU
-3XE5BDQfyff0RI3HMNUFQokKSRgs4OsBGtJGi0ampX4LsztY3q0hm+XrJAgasf0hSgRrkw/H0LnA2iSv
-G02mdS4YjxmcVqJtl6Jm2BwafJGD1tB+a8AGF9ZeAwlVIiRziVXRgnOcTdbSVFzK4aHh6PF7q1UaWpsc
-p4SPx3ZUBTexkFdmBbu92kJEMLL2/6GIGN6jORFF8YDKBgAAcgcEkwkoWXUZ/v0UBL+R9EZUp45nlNDs
-SfUFPn0EO4CSE2UnudMfWCt3kGxEJQthcIVPe0lYOPd8gnMTY+hLoxhGfZwRaWJjkaiFdAKfv4y8GR6w
-7u+OX8Nr9B5kIwa62ZMwZJILZIp5ni1mD6vs46d8lc04y9wTEE2Dqoj46wouByp8yKdIz+ZegYfZ0RQu
-0cXBhTu74l4lPp2EzjXVwkCItZBV2Mv9j35lnEhuhdl+uzckVRkNueKT/Hy5uVnks4fs9iZf/Gv1fSx3
-UKHy02N4B2+8nG5HLs8u0/r/NgWudX5oV6dk5VcIVeGfId9f3k51XWvlm+79Mxts0OjlCvmyKOYHKVXJ
-IrrZoUfCwfw/AQAA//8uGBAWLAQAAA==
+H4sIAAAAAAAC/7RZW2/iShJ+tn9FHSuK7BFjVto3ZrMSAjLDboA5CRmNlI2YDhTgjW+n3SSwlv/7qi+2
+2xibyTmZeRnTl6qvquv7uux0uzCIVggbDJEShit4OkBMIxaR2Ottok8wnMF0NofRcDx3TTMmy2eyQUhT
+96t8zDIzTd1xEEeUJeKHtwZ3EAUBhizL0tT3QlQ/wbLA6natyjz6CWZZt8ttDnySJFMSoJgIV8LeR+AW
+hxhTXHKIWWZ2u2a3C+VQD9gWIcAk4eC8BFbFFHihmBRBwdrz0RU2pXV2iPHIMSSM7pYMUtPg6ygJNwju
+tYf+KoEsk6OvHttqUZjGUZz/YXmk+Q7pzzgZTjGqGex2K/tOhLvmkM4HW/Xuzj3m8yj54yHmTz/+m0Rh
+z0pT9193s+mcbLLM+lFsK0JWiZiFGK3fJREFFC9J0wv9CBbapEL38QhSZmqYysMx17twCTaFD0cmHfiM
+rDRrO1oCUhMAeP4pXF1B6PlqhP97IRT+hzT6RvxyRzFLke1omC8Qw5mpTVC3dKkgK/wl+osIeld6Xnlp
+n0rJRaRlDLwg9pHnWHJW1n/whDSBaA0RtwZij9wvK/2c2ZAhXZMl8uJvX2s7ZjUI/RAEmRtPdBvxdWmq
+kJ2G+wba/yQPaqRvBFgVgPeo8LJyihL90AzAgXO5h7RSTOeqvrK13XYrGVRVh15DqZdmWorjLzF034Ho
+mfOFurXI3NacfuIba6HsdYKWMTWz/ojxNU5rj7x8v5BksEtYFHBpzbkxITTZEl8MvVKPYQKrHSXMi8IE
+SLgSpZsgU4TmBEGy3CqWbGi0i3mBi+L+Owgz64gGRWar95mj+7MdsB8enw4MO4CURtThZd7tlkQS8hH7
+xAvhGTHmEx6FiK6QdsSqOlSJS6mPtEY2G1yB9dESQIU1QlFEyzAEsmZI+d7ANAQb5ZIqcNPgx4CUSqSm
+ofD1rjSGGmKnaTTc1cXNOk6GCnc+XlQ8v2DcW/I6Ue1DeR/+Gw9Z1okCj2EQs4P1QxnMr8X6jwZJr04e
+AXxvIFkqctKTSbWpk7V1Mt5anKOeIHcW8/+JLxZxKdBYAr/lqmDwKXkm2ryoK7gqmshN5Kr6y+3bujnn
+k1ivGTVKlRG2TMPgQSlp9RM8dZ6/BEkNSFbviuodUv3wk1ePLbewl7qli5Zr8+p3qr1mtUCWJEFoEbae
+aRiNoadpNVdZ1pyMvBMXVajmVBNu6zLpVAOXyX9j5tS87sqWQTim1Mj7MNBUkiJZnRLJPymQH44VsuLN
+fgIpkY4UHh5Ri0ip6HtXMpzClP3UAfuD2OPY1Gksrzw/pboF5BntgMQPCaNeuHk80gWn3eelSuQ5f62S
+cIJfL/nVK+0/FLpkPaq71dBwaXVWoCto99KBy58TgRr/30i9Kt1AOGjm2k9EeXkJ8lTsF4cDtsKd71sC
+s+D3ZTNT06ySoVZu1nJWYWcxm/OTJ3T/toTWU7NvzbLW+0mGfv/+fcHpovIn5xMgwmUciXcJzkzOU0Gf
+nKUis0Ur8HTQ+5MO/80f4BkPZadcZWvVs+2AxpXiFSbNeNwK9ekF6dvbhrIcehKYbA0dO/R8p/ML2oM/
+7/DohVN9mPlCkm/E91aq5IpG9SthDGlYuua9l11RidoSg+6Pqr2sQLgCihvcx+5kl7BBFMSej3aaur/v
+IoYrZev4NnHM/LNPUy+rwKPtCBX/HPF8gJUPj7hgW+KNwUBKhZw+POorBfx8WVrVwb7vn5DCMl/F8MWL
+OMETM94aLl7cW/xj51Fc5aqSpu6IN26DLS6fJTre+PXjGEMJBqzr8ehmuLgd/X4/vh0Nrey06En7Ey8c
+MwwK1fLxqJeBf/AX3HJhg8/x9Fv/ZjxcjOejyWIwu5/Oz/gl+zN+/6n8kv17+pVVu8PbnY+1w9nh3TKK
+UQ1rI9J5Ywa1wxF7skzLWgPw2f18Mbte3Pann0dnU3XCQZmev+7AC28w3LCt5kYOVAIphtrO4WY0/Tz/
+cjagZn9lXO/mT0lE7u23FrFxJ4Qtt3fyXi6z7ZzE8LU/n49up4vJ+G7Snw/aUOAfHMh1RAPCwMKAeL5V
+4NFu63Ey4nNnXefhjyb98U179ENceyGuZqF/0EUk3AUyeP5xxrJOermfDkfX4+louBhN7yeLb/2b+7ZS
+OqbQmU7r1LNSAi64XAH+prebly0qnYqHpAd8p3RdaTPKvwHIu5h/c4tCselOfAHQLopalyCW2Y5q17Rm
+wJLuNfv/HwBoDel+BhkAAA==
 `,
 	},
 
 	"/generator/template/go_client.gogo": {
 		name:    "go_client.gogo",
 		local:   "generator/template/go_client.gogo",
-		size:    2379,
+		size:    9043,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/6yVX2/bNhfGr8VPcV7BeCGurlwU240BXzSJNwRYkyJxdlMUKC0d2VwlUiOpZo7A7z7w
-jyTbWbeg2B1N8hz+znMeHy0WsNlzDVwDg4rXCDsUqJjBErYHaJU0krUcsq+oNJci77qnLi9ksxiOKFks
-4JcxiJkl9D3kG94gWOsOr27h5nYD66vrTU5Iy4ovbIfQ9/mHsLSWEN60UhnISJJuDwZ1SpIURSFLLnaL
-37UUfkMpqfwRlwsuO8Nr90OgWeyNaVNCCTGH1uU23NQI+Q1r0FrQRnWFgZ4AALCWP9z96va42BFLSNWJ
-ArIWfjgLo3CP5p2/nXWqjhEUepK0ecyygk7VLkl8N7+UzVqpZw8nff8aFBM7hFkFyxUMF3/mWJfaKeXY
-zsH73uedVdbCZyfDMu37eJh+jiGvAUVp7VQKulJOSSisnXgZjVU4JIWmUwLSQjaNFODVTV2WiTV/j1qz
-HXrAf5f2WZH/aXVuzSuQCjKuL/jTWqmQirqNUG7csPZYirOufluJLX+aZJiePl1FZdaia3TcmqHL7SsO
-jwxahQNrgQtDSCGF9h4/SjMplIy1HweunKl+Y3WH4cpAQodmF7L0xhs966s6KU+wBrWja1j7cbz6KVzo
-SfINngloCUeNmceAwJFYMurn3/nogD5NZjznu5QlZtQJcqR8xoWh/iolJypPOCfZBnkoXOsxd0ZhK2Ud
-vRgz+4DVaiKYOgv2bDl63uxlqcc3/WSYjQWc+SlT+McVM8z/565F25nNofUXM4V6PLntzHg0dyYLRvPT
-xFn/3qiwvVyB+52/Z0rvWT2kpyThlb/wvxUI7qpMRt157WNDM9yoWq5gHFGvfPMe7q6tTZ3genzHDc38
-g9TGjbc5pKxta14ww6UIM3cOfhjnN/h40VUVqiyi0pfiJCVWqEChzi9kecgva6kxoyQUfXEwONKEgZ7f
-ISvf1a7uEPLil/QjN8XeP3VvmOn0pfcKSQqmEd6+ebP0gaElyxX8/6wrvbO8eyc24EE0sQUTagynJPkb
-qOdUDmvYjaFzd+wN6rF+DFjbMM0CFRcl/gn5besaoSF89tKXAYZE38Pnd0P4Ed5bj1eE2RrwZqdflpdQ
-hfjvpgrhE9VPQy9PAqXyRs1SLgwqwWrQqL5i/KPBElJ4FYfiiOZ8XGLFutr8U8ZOfBHyUYD2vvIzJaXO
-dMffhr8CAAD//1ut0G9LCQAA
+H4sIAAAAAAAC/8RZW3PctvV/Jj/F+XM8CpmsuPpnmpeNdzqxpDRuGyu1nPbB9owhEqtFzQVoAJSscPjd
+O+cA4GUvkuW6iR8sEjx3/M4F2PkcXq2FAWGAwUpUHK655JpZXsLVHdRaWcVqAekN10YomTfNb01eqM08
+fMri+Rz+0jMxu4C2hfyV2HDoOvx4dgEvLl7B+dnzV3kc16x4z645tG3+i3vsujgWm1ppC2kcte0xiBXk
+PzFzaTVnGyGvuy6OkqtmJVTiCLgs3dqd5Sa5h6lQ0vKPdouNy0KVQl7P/22UTHBBa6W3BP3C7PoXptnG
+EM9qsyXloM4dK4WaC9VYUaEqye18bW09KFN6Sx+9/qPh+q7rPEejq4PaMdREaMWGT6iyOMYXzeQ1h/yS
+6xtRcHSnbW+FXUN+qjYbLi2tVEJy/w5JAsl8nkBOX4K4oPOM15oXuN2jxUHUfD7iwf3vyRdg1xyMswMx
+V/afQEj6SLAiJOYjKfauRsRYYSsO+QuGDoOxuikstDEAAKvFry//jmtCXsddHK8aWUBaw9dbbBlccvsD
+UaeNrjxHBm0c1bmXsoRGV/HY9WABunmu9Y4J0RDnJytYLCEQ/ih4VRrMBADYcaFtSe6TVdfBOwTjImnb
+/G/8ruvaFqN6UVuhJKu6bqY2wvJNbe/almxK3nmRwcbeaY5OTy3N4BwhnmbeXzRZc9toCUmhNhslgXIg
+QSkjzPzMjWHXnBzA5T8QNhtnypeFzc62be/XrstufeI2Lo1cn25LeNsJwfjDVPx8vkfGnpCs0NiHA7LP
+nv8pDofClgrzTPx2rrVTleGCA6Zf6LoxaLcy9TBmr8RvA2CnUBuePIbPZbMxfukJl82GNrpfQGW04rT+
+0TAnAx+PcedH14GQNo4LJU3fTX0YBmRH+1yMtnx8Y0de7us8Ez+jXUej+XzCt8fXG1Y1n5DQU+3X6pS8
+c1s5ILiPwBLr9D9R9NTyLNTHQpVUy/uGQPCa4EyyDTeIig2rX/ekbx1BG0cHAnvIvAUkvZBkFkcjq6Iu
+7mFNWl+jeW+Har5t7akqeZrhPo8SIhXSZkSaxV2817iJtBCsDJ6bXnaawZVSlS+NXjIxLJdwwLMh/aDb
+efSV1dwUlGCTAaRvMHatSoN15smKiQoJsW4wWToGrvvpChIpqhlIUSUZPSdfIF23dHxSzu5pKRznNgSu
+5qZW0nADatWPO1wfm96LDbk8Q8ozZhniv6iUoeHZl4CyZyauPKY5nbuSh99RzvAdRRh01Sq/cq71DG7X
+oliPxVdKXrtged0k+JTJgldQ2I+OX9WgOdtWccVXSnMQ1qD+GdjeHEGOSxSQn2udZvn+Wjifb9e77Tg+
+ouztjHeIsj5HtgSn6Js/CuSn7i/G/wPF/2vX/ZAU8ueybuyru5rEpGGLnh4XayanlBeN7UlnQ9gDLcVm
+hn/cI06YZH5l+JfpFr9b9B4RqHsitBsJV/9wCF8soR++v6FS8+vL50Pn8QedZ6qkExEOKJfWB3exBHzP
+f2barFkVjM3iSKyI4P+WWDGwVoZi6UuNNypU4A+9PDye5S/47Uv+oeHGpli7f7K2/tniuDPDw8EM6OiJ
+VM+a1Yrr1BuVPU4x6s1/4qzkOr/kNk0IndIeY9SSGSSsritRMJy+3Gk1i6MRjKIPeEzsLac3385+n0B8
+4w2Qonqk53tGit1ajObAElM1/5ewa5+7mM/Zbux+KApeW4waEs35DQbSJWaSTRRqbqZOnvEVayp7Wgku
+bX6mMHqf4c8BJ6yiSRa1YealxjLbGBDSzgi8z+4sh9dvEVKZL6qoy9wKW6zBU4epQ6xAyJJ/DEO5AXd9
+gc0wigpmOPzp5GQRR1F05UbvxRKO2vYQU4tswdOQTb/Kjc+nYN8MnLTs++2Q9EGhSERRFw9Ljmc68Hgb
+v3U2Fmoz2OheLlZUjx5hmuN7nGmOJ5jznQ/ZQK80pXeaCGm5lqzyndx9gwUk8I2fGHtLMPuj0mHpkLhG
+vpfqVoZtxfkK0RnRKIjOam7yS/qIox668+3JCblT8hXX9B1LYX6KbT1F3iESPlLuvil/yVn5Q1WlgSWL
++3COo+StDNOVxzRFa+eTx3I6tXLAcebKiGqsG5/f8/T+3pnFEdfaTKl99/z/LI6ulUua7P4QuA806aQo
+b3tNNRaXfHxwwDnH+mD62MycGqoafl9nUGJT281MCiIRLpchk6aAC2EiKveIsgggAYauZS6WIPltek94
+7kkBlNlPkp+If8Mr7i6tPPZxr54eBymLMZ8UVU/29BgnvDMleZpNiPrBL6joDuBsPqeZ5EqVd7QDgDXU
+uImUgdVMGroGdoFWsuAomwYbJbkPRK9t6ijqWu6a4rD19HiAdJdm/bFJNZbAbgjc4SzjW+sBqMWPybaD
+DWRIsy4OhX4r8dvYxf1bV5oegRcXi4PVMsDl4WKwUwc8qwvXg+0IAGDckD69Hz3ggW9Fn+EArYauNL0U
+G7elB7vSAwb6hvS5Bjr2warvAgQmjJ/boUYN6pDEA02qu+e2bXqs3v4BBQ8vdViAldIbZg0wWoMaF7nl
+Ohx7tRs46Rqezio9a3oD5O2KFbzt9lwNNrrKUfO5KVjN09XG5pe1FtKmN1kWHzA1/NiCVo5maKAfiriZ
+2MTcK5EF9d5sOhhswpU5kyVUwqCbmsO1uOEShIS/Xl68cE7tmdanzqWhDfXnpv/67JMkQ9lZubuh0Hjx
+qsspfEtSX7Jbf/0/CNwLebLnyEnLvn9YsT+1oGLcLbqqM5hVK6XhPb+b+YtB7NN0S+QNRWk3TIMJP/NQ
+dm0ZRKwzODLT/mBgGVKBKPzE5Syh4wMpNlmYwiouU/qYYY8/2XaF6l83XEj/GXPNCTsn0KTZpKdsI258
+PkDUDQMJPTqMuaPE8SWXFvxHtQLmLxt42V82zWDNZFnRXWrBqoqXrqmiELxUJCziC80zioYXfQc0nDgs
+jgYias9CUTvjuhf9SdPRaEjSxI6bSL/cunMkLpGCLKatHMtzKyOZcbRmfc9bscpwBxHcCrzg65PAqSKD
+scaZ9Ks38qvd4nt0FN6Eys8vfpxU46EQo2hY+gP+Ky02L8X12qZOY/JGv6FDeGjabThBIGCQxuFl4ecd
+BlcVk++BhHLpN5bcng2PbgbCOYzcZ5qDVBZKYWpmizUv/ewT4kGGj6ZCt0WpF1semAWnrjpn3X8jxlmv
+ZBmAPgvBd466wGCB13wlPvrAuC1LE5SxSLJssdfk0glmdc1l6afXsFnOlH0Uw1aMVL7GgAd1i7eDBZBk
+WZ7nJHLwxermEz2gYPQu+DE/FI/BlMuaFXxkSWBbvM1CcQlFcy/g3GjduaaKJWA4Kvi7d+PvX/sW3Kcx
+pSiWAhmuhD2g+EeLHwV3TYcuhZmhnuM9MC7ft84lfRandCkR3lqfpaMfuqPL/vICxv/8z4bO2uRdHEXO
+FdjqJYHQjXvvQrm973hzxA92le9OThxsSQ7aSiEIBWW3PfD83B0tj5CQcnVLJPczeA8IIhwX+4HCS5sU
++f8MAEKOwaRTIwAA
 `,
 	},
 
 	"/generator/template/markdown.gomd": {
 		name:    "markdown.gomd",
 		local:   "generator/template/markdown.gomd",
-		size:    1858,
+		size:    2475,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7RVQWsTQRS+7694Nj20hd3ciwpiK1baLqSp50yzL8lqdmY7OxuokwHtxYNgLiqi4KmI
-J6soiFLsnzFpbv4FmZ2dZDcthZQ6p8y877037/u+ndy84bruUr0TJhAmQKAVdhHaSJETgQHsHUDMmWAk
-DmGphzwJGfXS9EnqNVlUtaHlZde97ZhSaz5s+3VYX9uoe9mxIyUntI2wGKGA1VvgbaHosCBRCqSERZaK
-OBX3QuwGiQ630W48PwvVD2IEpaSsruggiA5CyyBanEVgCkCESULamIBgsIfQZLSHXM8gGDxKGIWVqlJO
-BaTUF/G2SYRKOU6lUoGzz0/HH5+NBoPx6Ze/J+8c14LusihCKixufPxj9PVwt7ZpQI0ctVvbUKpRhIze
-/ByeDGwpwXbjGHlGgHdfiHhLBLbkcHA4ep01LdBkedjKR/JbGQdZ/ga1lJS3erZ8uCQfDqQMW4D7MDk7
-l+PWfL/uSok0sHhqrmBnh4UFpZbyuhM+lvMUpw8x4SRCgRyo7gB94LifhhwD/Vvom0IfAkyaPIxFyKjT
-h1W3tKC/WvhtolBCOVK6YPjxjDt0byntpPkq9s62UnprRBAzrJkvTGoYIxHgbZI97Cp1h3NyYCnIUqay
-67aWm+oKtBhH0uxY+yENjKvy7AyjW2iCp1FjjNNXw/cfzo5+/fn9QsvtNBoNbUtHyog8xgc7/nb5W1BK
-Q4rJxivj4++jty/ncEzhK5rZz+GZYtJ/MY0xynRdahkdn/HQVQ1zbQ6ZxyD5k3Vh2JaIzCs5Y6N1mkYX
-OABpGmVvq44nE131cUlYmmPPCVU8LCmVTTJRqUe6KV6qTkGZqyvyULe5Dq4txvQtUzk8ej769kn/Pxli
-vB3kvbA54+LsBT8XmrZx/gUAAP//nIj5n0IHAAA=
+H4sIAAAAAAAC/8yWT28bRRTA7/spHk4OdpRd36NSCUgQgTquYpezx95ne1rv7HZm1hDGI0EvHCqRA3+K
+AuopQogDBVFBKRH5MvHGnPoV0MzsxrtOVRTIgbl45r0379/8dsY3XvN9v94dUwFUAIEhnSCMkCEnEkPo
+H0DCYxmThEJ9ilzQmAVp+lEaDOKoWagaDd+/6TlX223Ya3dhZ3u3G1ixpxQnbISwHqGErdchaKEcx6HQ
+GpSC9TiVSSrfpjgJhVGPsFgEbavqHiQIWivV3DBKkGOEobMY8jgC5wAiFIKMUICMoY8wiNkUualBxnBX
+xAw2mlp7a6CUkJzeQ6jHHNaDDvIpHaAItjHhOLBVm0xL64YT7JEItfa8tbU1OP/x48V3n2SHh4vTn16c
+HHk+KEVZiExCDaDmNrwVRxEyqbWnlA906KSdlAk0Qh/Onj08f36aPfr1r0dPX5wcKVU1MLuQhUXMxZPf
+sp8f3Nm/5QL2cvM7+7ta96xx3ue+aaPVvUlZSNlImO7RITCjDG4TOXZqM7OJ9JSSnEa3OQ7ph1Br1go7
+rXtQV2q9H7wjZdKSodYNpWxSSl3KLfvq9/nJYdGPuzFlUNssupE7KHJxpSKfIu9IjiSibKQ11J3I75hW
+7kyRSbEJi9Mv5t88nh8fnT374fyPz7PH3zYq0eeHD7Iv7TmUWCtgauVctIcWJBt3lxVcVZcGkBIhxssK
+FyLHAGwReH8pu+TK32+3u36eKCj1AXV9F0sw6oaaCWUIwbKx3gwSwkmEEjkw4xlmwPF+SjmGZi5N4jCD
+EMWA00TSmHkz2PIrA2ZbpTlslX/dKCETuC/OxL4ovlx48B4eaA35mLnig7YNTSZax/lMKZwI1LrItii+
+vGtXtEiidYskN5Qyjl23NkGpYJtI4pY3C1cVqXNBxT4mSCQEt0gfJ1q/wTk5qGLpwuW9rX6IxXk0N2AY
+cySDcXGdIAvdLXHhq7lhvltqDnWpdcRbJs+Pn5/9+dCQ5/V6PXPNeEpF5B6+22nvVe82rY1JebPDdvHk
+afb1Z1eAt3Qrrqz/O75lX9fKr2N2OV5Jr9Gv4Hzd7P4/KLwKhPkz91J14SJyL+sKqjssjV5CGbI0su+x
+0YtVdoy2Ao8VXNDjYLCyf6TBFnZBwpRMUnwlAaXT//ennmdaOvbgfRP5Gg+lsHEZVXs+P/40++V7zyve
+3eX/jDwi1Gr26a3+b7hktgzp/T0A0PlS2asJAAA=
 `,
 	},
 
 	"/generator/template/php_client.gophp": {
 		name:    "php_client.gophp",
 		local:   "generator/template/php_client.gophp",
-		size:    3870,
+		size:    8201,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5RWWW/jNhB+16+YGgZsB46SbR+6iOsU2cRtA+RYbJMCxWZh0PI4ZiuRikht4gj87wUp
-idZB2a4egpic45uPc/3ya7yOvZMTeFhTAVQAgRUNEZ6RYUIkLmGxgTjhkpOYnsXrOAgpMgnD75gIypmf
-pu+pH/DopBQaaWtX93B3/wCzq+sH3/MYiVDEJEDIMv+ORPin/qHUxPNSgfA35++cP33WBi5iOjGHt5vL
-G7IQTzOWRubPxPNOjo7gFoUgzyjg6OjEy7JjSAh7RvDLc6W8ICRCQJZJKkME41ApI0tXQMUn+j5LkvIc
-8E0iWwoo3T/l9zyZvQUYS8qZUcVQYK5/yaOd+pc8ijhzmWBLpYBGcYgRMllRKcB7mQcAUInqN4rhUoBS
-5kJTjIF+lH5BpOawVDHmvVwwXYQ0gFXKAu0dKKNySJKEbKCfoIg5EzjKFc3fnV71R1cwpEKgHFr9rz2L
-ofdtNKpYKq3RFeAL+DdkgSH0bi4+zW7mX2afZxcPs6texbj++nJNxfG5NQlTMHiHo0lNbsUTJMEaOnAA
-ERVumqAqwKi4X/yDgQT/ikjysIkRGohyVFEMU2D4uk2nUl6pJrZS4/jc8F3B0SX3nYR0SSS6LdUZ+foN
-pkZt4oxJ56czApeVRva0bJlMqp4q7wB/B1PreOwDKW5qllS7k3Kfbhf9HfE5YLsdO6y1GG2fKs9911HT
-W/D/t5B/KCq5EU6rhuU64a/mZWyj+t2MhdD2tWFvYPUHenwwLgHfqJC9CqfdkeVn7mbXiFegnFtfwyxz
-ZppSjbYPWWb8VYqxQZfrVRv10fECzzVETbsJyjRhLfOTWuRbLprGJZ8XDdBtN7887NF3FmdDrpLHMD13
-FM0W2PiQotljb7ynKNono5JBPdLzK70X6BWhtRSYw66NwE5uLbVv9AacCQnVJMky/y8Spo4RvAVW+rU7
-SHOMr6WML81GNXHn2HxuPCdpIIf9BRH4mFCYwuDDjz/7p/6p/+Hs4+nH00FHUm/NFy3WFvIf9mZYe4FG
-WpXfQLuepwkdmEcsgIzbcpJGyFNpxH46rQuM2m9YEtcP9NIUoV6a4GwKfr5kFY+gGo3iFuWa2+MmZdUm
-4V+zOJV5mutm/dIiak3YMsQEplsD23Eyhv6CvhtU4wIja3ZJ01ExiuVmqzdybR761k46/z6VFplz/Cco
-mtOtU2zXHlH2oQRF/VLZndbiL4N1418Ui7ENgrIlvoF/b0aBgJ7R7XXEU2qXQVlfbdl88FiNvbCLh3Gv
-e3nO1BLs2LEoVTbc3Nzu/daG1V5i9myIda2SDZdPt25Bzo4VrmCoaELZDit7Zvsj+5fxVwY5OMjJk5sY
-z6Dn78rKXcvjQZ7vOCyJJEXy4tKvrRNFr2xP2W2/Oz4PSBhexFRXz8vYjCHd827lUqle/vvxy7X5v+wB
-tisVzCnvvwAAAP//2W6eJx4PAAA=
+H4sIAAAAAAAC/9xZbW/bOBL+rl8xKxiIXSRK9u5wV8TrFNnGvQ0uaYpsWuCwXRi0NI55lUiFpJy4Av/7
+gdSL9Wo3jXsfzh/iWOQM55l5NBwOf3kTL2Pn+BjullQClUBgQUOEe2QoiMIA5muIBVecxPQ0XsZ+SJEp
+GK5QSMqZlyRfE8/n0XExaWS0XdzA+5s7mF5c3nmOw0iEMiY+Qpp670mEv5sfWo8dJ5EI/+b8K+efPxgF
+5zEd24fX67dXZC4/T1kS2T9jxzl+9QquUUpyjxJevTp20vQIBGH3CF7xXGv79JGqJXhveRQhU/bZPOT+
+l/wBuC54+UxkgdaOHxIpIU3ttzERrKH5HLoAKn+lX6dCFM8BnxSyQEJh9udsnIvpk4+xopxl6kOJmfxb
+Hm2VN7Zx1qXCWAg0ikM0xldEctBO6gAAVLzxjmIYSNC6HGg6JHtedwoAQOaYQipzDgBYDqBvCDHIg2ji
+V5+XTUzmIfVhkTDfIADKqBoSIcgaBgJlzJnEUSZo/2613HzoAoZUSlTDUv4PN029f+Faa/fP0aiiqFBG
+F+BdymsSa10bG6gllUdnJQKYgDVtOBrX5i24QOIvoXtJIBIGX3ANk7OKN5p2VGyh8mb+H/QVeBdEkbt1
+jKB1a/JARTFMgOFjnYiFjNZNMwupozPr5YotffNWJKQBUditqe6cPwzGP2FiRced2Ay7tX6GpgZ3WvpK
+vhUf7XStaZyKD+BdkTmG4F6d/zq9mt1OP0zP76YX7o8L+v9xsPcT6H0GWWvnu9zbEe9nuLkpXbi7M/fs
+Eu2LQA/EDss71x07u33afqqd7rGexL2x/TnZOo8S4wqGXIB3Y/cxEoJ3w5AvwLtFPxGSrtBk9fdJGJJ5
+WAnIaNTM/T/lyb/hm1beV0vBH22ky/3xn7aKCcvtdOgelPIHQKU1E5+oVG4lQH1+qm4unzLnGD81Rgcr
+OJ1sm0AXMFh5t/iQUIFBEy1GsVr/GLAiX/KZWAcr7zciLxVGt0mItWAPfJ4wBRPoCRG8ATujY+QUTsYd
+K11TZlZqFQD5Sr9AmlZmaf1ivyyJhBClBLUkrKkdqPl+vr+uydM2FGf5OuRpnygiLrCKgjx9N4otPPhE
+wgRbRKjUaLuYW+6yabpjE2+qStMsZWabd2uhNLVm2316ZazcskdnUe7al22YrHiFbN37/fe+h226ae12
+bNH6G3fPFvd24NrQb7+47gUShaLOwb1B4yKP2hWye7XMoeY/OsQGYTY0gZJoZcHgztcKpau1VCJENpwT
+iX//2yxAnweYu2k0KvgWzWf5vGzkENyPd++OXrsF5cbfF6gSSl+4cgQbHmbz9xs1ueSiEbXNQnul5beh
+3bDzR6ANObtvUnT/YD8QpVD05pefYoH3s4gofzlM03gZ3+I9PlUEtT4sctjewAccs3rHrgtqiRBnq+0J
+Nz4YBO+4iIgCFyNCQ7fPAwsaKhSzFRHlO/Xu8upuejv7dH51eXF+N51Nr88vr0YwmUxgQUKJ+2SBcQNh
+YG0EEgQCpdxf9C9wQRkGNyxc9zKg5zByekqlrRyH+w5/gTor6/sOQy9xgn5BeVE9jGTPOg8YzSOKRDUr
+MWYFRdH2yTpOWQrvPjZqnaaKqrBs9UGezisn68bJp+t81jjs9hym7muWNvUKVIlgLfXjmkcqvbiGcsVn
+eUOjW282+G3ntwqVCQtyd8Kw7b5RQ2hzPDVtMbvkLCLxsLRyOFiNIC2xro7ONnaPQR+24I8Oe3s/nc29
+ugVNbf3KDM7GWbU8pI6eD71NkskEWBKG8Cb7Ou1oFGxc0W9nF4WfYcfuRV7qzx0v+qjgs3YKNpuOvmnu
+t9r59uG+e/llz91o/180zX3OpDKWmO+aJaYq9exZqt1G37inYtzvKFbUL+83Br65LYjQ3BaYfoNvLxdy
+BN4LHVdefTQ7/0ul4rf2AmjcneZmMwtVJL4aDkxV/VFQmMDBz3/5h3finXg/n74+eX1y0JNXN+phUt/b
+fitHhjXaNTJb8TkwS88SQQ8sc3NDDtvzFI2QJ8pO++tJfcKoTdza1nSNaskDuc87loY3q1tbhcyXLE5U
+9vrDQOBDy5tLwoIQBUygknyLPuIhDOb0qyXOYU4j1qwybImSd6IKuc5SxIx2tVdvElXa2Nl9FiibjdXe
+adva2MVuIlDWB3WZNkskBexuJPP8+q6EQ1mAT8WmIMG1sm4PnkK6AFWuNXa6S7dSYqfZeYi6uxkZF2vZ
+4Kin5sz7M5m6rTdoxWfQbqHvuJ+oSxXO6FhyvKWq3XZ/kPsnT5AvqI0/si+MPzLIbIPMdWod4ym43jZO
+bru5+KaV33MIiCI5dTHwap25sVP+X68KN8nx6MwnYXgeU/PuPByafTrkjyjAM2nyWgVau4dgD5Yfby/B
+s6fJPCeUqSz3YPnPfwcACiILBAkgAAA=
+`,
+	},
+
+	"/generator/template/spring_duration.gojava": {
+		name:    "spring_duration.gojava",
+		local:   "generator/template/spring_duration.gojava",
+		size:    2537,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/7RV3W7jNhO911PMJ+ADpKzKOFi0QNZN2zRJiyy6thFvuyiwN7Q4lrmRSIEc2d6mfveC
+oiRLjpP6pr6xMD88M2cOh+fncKMFQoYKDScUsPgKpdGkeSnHcDuFyfQj3N3ef2RBUPL0kWcIT09s5j8n
+vMDdbhwEsii1IUh1wZbcEpptkbMvPH20WrFUG2TvrVa/ehRtxicmzLix+G/RghNfSCXYLVo0kufyL05S
+qxutCLd0ava8yUUzM3otxem4wuEyS4LNSeyLOP2AXvq8l9xmf+FrzqRm99O7bYql62088BWcVuxnmd1i
+KgueH3E+6EoJqbIPWuDQTbJAdlsZ7o8Nzs/OAjiD1uKmAJHAuOvKgmh8FmiFsOGNYt7C+/l0AqhSLdBC
+pnWWI6tdi2rZYSSQy0eE8IJ9OxrZMICz86CsFrlMYSkVzyHNubXDAp4CAIDSyDUnHLiiuHHuAh/jT7LE
+SaYwJyNVBkttCk5RmwdrnlfYJrqfVASKK23hyjtZhjThSkfxeBBkU54jXLXBVzCCH2EE7xrD/+FiVP9a
+19sDV2v/Dt7B5f7o/ezAYqqVcIXsjayuabqMutrmPiqKY8aFiI6E1qgJXMa9DgxSZVQLwSzS3PUT1V0l
+0BcJ+30yubu5m8+vH/6MGelZzqXydEYxvIHQhuOXee+YLt0FjnweuNs4YH0J0f+ckaES9pOkVRTaMO6H
+uB+tjN6Awg3c5zlmPL82WVWgou42RKFUa55L0UkTQnhT47lSE8BtiSmh6NitJfjZadB+DsMeRbtjM6k5
+dVPHTc8c1aXbamE9LaOkRmQ5qoxWUQzfwEWf/VyrrDdeP8tuBqMD/n/5bTp9iJlL+sNF3m15SodyHGrW
+VgsyLuqIHBrcOGaFXuNMS0UPMltRdBkzqeg4RKOXdppML1vdNcclvoL4FSn427xfa4BbcuOGwbL7vsX4
+oTf85qB9VHQoDVuVaLp7zWqswTC7z5+mazRGCjw8fa2lk0UDcbAjEhg8W+6ZTOD5QwFl8xF7sVroreqD
+kjNUbGMkYXOZmt3kV9IzIb5Caf+h6ZPat79Gaz/uvyC2TQaxB4r2j7pfDSaB4682pP7/BEbJfD2w9LTr
+F5DHcouzFvq1bTdZr6e6L0g5pSuIXto08DdcG0mrAkmmeyvGL1fQsRi3PbEVVyLHTyiN8IXUVR3QncCL
+VSdQPwIf0Fqe4fMunoloF/wzAMMeHoPpCQAA
 `,
 	},
 
 	"/generator/template/spring_service.gojava": {
 		name:    "spring_service.gojava",
 		local:   "generator/template/spring_service.gojava",
-		size:    856,
+		size:    1794,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/6yRTY7iMBCF9zlFKauwGHOAbBADQiwgaMgFKkkRLKDssSuNUOS7t8JfsuhuqRFZRX5+
-z++rGo/hr6kIamJyKFRBcQHrjBi0OoVZBussh/lsmasoslgesCZoW7W5/YaQRpE+WeMEjKuVt05zvXN4
-orNxB3WmQhWaK4XMRlC0YbUgWaG1muv0t9aN8S97/5G3hj1NTXV5wfy/IS83b2Sb4qhLwMKLw1KgPKL3
-3VTWeKIQpugJ2ggAoG3/gEOuCdSKZG8qDyE8Fb0DJlBbch+6pPxiCeLFPI8fdyYD3iS+Tl32IcSjmzok
-up7ce7WtyhqxjXSJIfTFurhkMmDppCX3NzWP7sW7z5E0jnt7onmUXtUegbjq2v6ItMm2A6Z+/e9AWpAk
-b4V4LvXbJ794L71HPZJC9BkAAP//3o5aN1gDAAA=
+H4sIAAAAAAAC/7RUy27bOhDd6ysGRhY24Mvsr28unBeQpEhsNEb2lDSW2VgkS1JxjAH/vaDeioy0WXQl
+kZzHOWcOeX4O1ypFyFCi4Q5TiI+gjXKKa7GAmxU8rTZwe3O/YVGkefLKMwQitq5+vV9ERIbLDIE9cq2F
+zO5zrYyz3kei/ANlMma1ETLbGp7jQZlXdsCYxUKmjEupHHdCSUbEqnr/AMr06/nf0WolLV6p9Lj4evLP
+Aq2rcgMEsQXWUSGCmuWI3gh29xcRHYTbAbtWeY7SeU8U71XyWq9hMgFWZpTxRKHpDWqDSZiF98tu0QTp
+It6LBHhsneGJg2TPrQ0gnniO3l9xi0ARAEDD4o7bNXe7NTc8D7jD2fKycOogDKblUhvxxh3CKv6BiQuD
+RAOqt1g07dvKzdDR7VRqoXdylsO/F8DgRHDlkEF0KdBZ3klUnwx1CnuVVk3eEI3Yhhp96Sqa3c6JxCUR
+u2wt0Gz2bTTQcSRiPQqis5ytCqcL98Df+Oao0ftqt5oJEXsutlvx7v20mvEdt6G898ue72r1n8J9lCpF
+Itxb7GLK3vDI9X/PLhh6DtX3f9Alqv6EPgjfhz6HZVi+cCN4vMfppPXOZFZXDHYKVBr4Zd0ZuJ1RBwsP
+Vsm1UQlaK2R2+56gDvoBDTqLLUjlelTb0w804WLgNPbG9wVu1MYgTitis8Wg8h+xbM9DC6YLV/L8hkfv
+J/Mhv0/LG3SFkdU0u5TpALEziBv1EnBPQ7t5FX4vB45g5U2d1d16Ri6H/FcM1b4rYzQg5AzodzSFHMOV
+KfjRcvTQfX6LG6s2V+uzi9u3dfvqEZ0SqA+d6BTlRY2rwe2jXwMAwVT5qwIHAAA=
 `,
 	},
 
 	"/generator/template/spring_struct.gojava": {
 		name:    "spring_struct.gojava",
 		local:   "generator/template/spring_struct.gojava",
-		size:    585,
+		size:    3162,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5SPwWoCMRCG73mKOdqD8QGWQkFbqBT1sC8w7o7baDYJk1mphLx7ibqtUmhpTjOB+f7v
-n81g7luCjhwxCrWwPUFgLx6DqWCxhtW6hufFa62VCtgcsCNISW8uY86VUqYPngUa3+sdRiH+6K3eY3OI
-3ml0zguK8U4vo3dzJhTP1b+ONuwDsZy+s/Z4RD2IsfrNRKmUCsPWmgYaizEWv3kZVthTzpAUAEBKU2B0
-HYF+MWTbmPP5P7A5ohDsjENbTpd4xPoUzpcJdIFA6TlCyLWQszrvTzedLrSLx73BpKzeCQ+NbJCxz/nh
-D6vy5N1EfaMAj78KlfWqldIdE6Zj1y+7m5IdScHWRmzhTka18phkYPczNl9jxuysPgMAAP//ioLHmkkC
-AAA=
+H4sIAAAAAAAC/6RWTW/jNhC961dMAx/soGHuNQIYiFs024UToNleC1oa22woUiCpZFOB/31BSpRI2Uri
+jS/mx/C9meGboa6v4VYWCHsUqKjBAravUClpJK3YEtb3sLl/hN/Xd48kyyqaP9E9QtOQh3Zo7TLLWFlJ
+ZSCXJdlRbVB9Lzn5j+ZPWgpChZCGGiYF+aKluFVIjVTLrGmugO2A3AuUO23tWSh3eyEVLs87I3JeF9gy
+oyjOpHxQskJlXgfP/6R6XStv8R5WQQ3dMlGMQdeoUTHK2f+4/DmEv4fzQ1zBwzuPqP0KKCr2GK91fE1D
+3C2mp8PohZkDkFtZliiMX9lymT91C3BxAcTapklp11gpzJ2YrM1WwyzYVfWWsxxyTrV29LdusKElWgtN
+BgDgkDp//2DIC+duWGc7ENJ0wunWK8WeqUHYMUG5g/xCn+nja+URG/BTRwAu0gCEogBrp6YdfS/PkzSP
+zPCPcPj5KtJ/C9fmIc3A3E2lMKrOzQNVtLR28ZNZcT9zYJqM3IObzyVlJuG3m1FqWouw3/vHdjAfs/9y
+A6LmPEQ19nQmR74KfIGmmcmQbTIk/h/KaxwTLJY9rgXkGqET3kf5as4jiFO56G40BNyFC1d9qpLriNZH
+9TQuJwAAX1KduaNMQJPaikpryl4gkL/wdciPtau4m80vmqa1sPZiMcl6F3W6VdJ2nFzD3jfNxN6nMKw4
+U9LbKuJL/hTNUApR5e7ROO/8XYO181gxCk2txGkdB88hDeTjYna7/qZmMup97c7bFxY6Z1/v7UuVxjhI
+uQ1xmE+EOJPTQR6VXBrBJ/w/2c59YEe6Owr7DX2tosd4Ho1J+N/cb/7dfPv6dZF4EUtwwBl0WDvxfVR6
+sb9nS6/raemlABPaUJG7cn+rWY27XnfJ8/k7h8Z8C7JH07a/xbhZRbhDL4taShf49eWl/4fLxGM4SNfL
+qIFSagNSIJRYblGB3LlZCLDtJr+COSDoeuvzCwY51/ByYPnBn2QaNJqO5jrOt3YfMTnQrTaK5qb/Gogc
+GTIVHt7JWokfoSs4rokBKWFvH/JAneQc8LtBUUy69N5nx7MDWWapfS+10ft1dHAsk/7B8rtwE+DjfTtF
+luq6E80Jgk4zJ6FPJ3oQ07jJ2uzHAMJJpcdaDAAA
 `,
 	},
 
 	"/generator/template/ts/helper.gots": {
 		name:    "helper.gots",
 		local:   "generator/template/ts/helper.gots",
-		size:    3640,
+		size:    6973,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/4xXX28TxxZ/96c417qXXTtmncsFCRmWEEIguQpxrpO83CiqJt7jeMh6djsz68Q1llq1
-lLYqLQ+0D+1DK1WVqHiAp6oCoX6ZhPAxqjOzu97EDs0+RNlzfud3/s6Zdb1aLVVho8sVdHiIwBXsokDJ
-NAawMwAnlpGOWMwdA0OLakdCMy4UdDGMUUInEW3NI6FAd5mG/SgJA9hBSBQGwEWBksXcUNQgUQkLwwGp
-Ha/OYu5p5UAk6e3g4GAdZZ+3kYSp5zFJOwpMpPuSa42CKDYGMa63JY91qQoXz/+UqvDuxa9vv390+Pr3
-46c/vf3iyeGrb7KcS1WwmqOvHx49eX74x8eHr348evTm7Xcvjx5/e/TVb9bi+IfPjr58fPz8xbuXnx4/
-fTa/tjw2/Pzh4etfjn/+5PDNn8dPnxWjrJeGQ94B7y4Kb4mphajXi8SilJEcjUq8F0dSwxB6LC5oyB5G
-0JFRj8o0HHoLIVNqlfVwNGru3FdOaThEEYxGJWosVOE2drjAAJa0jmGB6taJJEhUcSQUQpeJIORitwTV
-egkPjFMUSQ+6WscGPiwBANxevDO/ubIBPszWjGC12bo3vwI+XJpNJbeW///BYqvVbIEPlzPhQvPevebq
-WH4plS+vbiy2VudXcs0VssiipsBvxkyyHgyzUEfjoHUXAakcuagYfjaLFrKUJuiilJUGrMmoxxVeF9hH
-eSNNjndI6+X8vu9DIgJbuUoKokd3ZbRPvNeMaGT+hqghYJpZmZaDggHJwYf/rjdXvZhJhScceaSupFzQ
-ZrrdBRPnJMOEWTECtc8z0zFIaaYTVaRqM2p42lcvb1cjB9AjUSdSZHXyJN7HtnbTQN8zsNOdFNt/0g9V
-zfpalBL8KXPuFqrz/uhyHhOiHf9xdWzTbM1GpckJ67MQGCgt7TGAm5ZOAROAglZNkGrtcmszQauNxTGK
-AHRkVloiw+kzaAjcPgsbKUkl+yftTJqSBW62lheiXhwJFJqMKl5pnHocsja69X9dnq3v8ho4N52p6v/M
-W3VjuvrS5fpuDZx/nqFdsMa1M9SzxnhmuvbKLWu8dYb6tlVvO5VCJ+BWwsMAGGy2VujCsXWl+pj2KKqw
-OfAiKDQtkSE0jHyHBo5eaa+RQNmLowBOiSxe4ocJKg3RDs2OB+jtesZ2CcMwaqXa/AYjEwuFqAOa9m8R
-WByYBuSTA50kDGFThlmvLxoXdxc3KMU9HNT7LEwQYsalMhx4wHpxiA16oZTI1ocynaRGvR5GbRZ2I6Ub
-V2evzpYJxOQu+DAUrIcNKO+j2N1HXq6B4O09K9CcifKIsFlc/g2YSjhHLH5OcoE4/Jxi6mBnl/H/EpSD
-TRle37jhJjKf8lpa9AZsnB543gH3H1Zb3E7pOUhkmK22fLvGTGqVsWxtgw9b29dKVk9ldQm0h+ZTYpKY
-lH0WjhcJBdC03TcXPTXV6zLV3BdrMopR6oG7h4NKkYQeWhR+6mBrDwfbY8o02IzdIH0fBE3BgwdmbKIO
-ZGInv1yc0z7SKjjOVG6TZ4141Fhfr5NProBJyQan4/B0tG7q5las7610mucJvT0RwB74ppQz4GxtF6MA
-DBVOB5+ukqIO9Vk4vUAE8DqRXGTtrtunmTxJmucTMI0nNCalMxO6w0OczMd4BB/Ibnm9mZlemwDlbi3d
-CX2aPPnPWml9W+yZTs29b8eWdwZu/5Tb0Yk3M+VenKium94aexVqg+/ATH6PVCrjklZOf4UolJyF/CMM
-1uzG81PS+xEXrnOB9m5+BE+DiznQOp3xgQ60x0WAB82O68w5tuAX/w1z4Mw50ACihJkJv8W4xsd66uVL
-ns65xVMJfesWEUC7qwDLNlSOy1dWDkx37blXbdGzD2Wz/9OfJ0Z/wqUP5XU2MJjyeXZvvUjnZabv3bnT
-1m0hxrGwGNjffH+YlkO5Xrb9zPOdgbJHsiIV3d5/BQAA//+K7/vOOA4AAA==
+H4sIAAAAAAAC/6wYTW8bx/XOX/EipFmuRC+VNC4CyitbllhLhS25En2pohbD3UdxrOXsZmZWFMsQaNGm
+aYumzcHtoT20QFEgRQ7JqSgSBP0zkpWfUbyZ/SK5coQgPHB33/e8r3kz7dXVxir0hlzBgEcIXMEpCpRM
+Ywj9CTiJjHXMEu4YMrRUQSw040LBEKMEJQxSEWgeCwV6yDSM4zQKoY+QKgyBi4pIlnAjogWpSlkUTQjt
+eG2WcE8rB2JJXxcXF0coz3mABMw0l0KCODSWjiXXGgWJ6E0SPAokT3RjFe7c/tdYha8/+9fLv3x4+eV/
+rl/8/eVvP7784o/5mhurYDFXf/jg6uNPL//7i8sv/nb14Vcv//z51Ud/uvr9vy3H9V9/ffW7j64//ezr
+z391/eKTrad7JeNvPrj88p/X//jl5Vf/u37xSdXKdmM65QPwHqHwdpnajkejWHSljOVs1uCjJJYapjCd
+GoIK9glLEpSzGcxgIOMRuWs69Q76z9U+G+Fs5jSmUxThbNag2MIq7OCACwxhV+sEtsl1g1iCRJXEQiEM
+mQgjLk4bsNpu4IXRiyIdwVDrxJBPGwAAO90fbj173AMf1lsGsH9w+GTrMfjw1noGebj3k591Dw8PDsGH
+t3Pg9sGTJwf7JfytDL633+se7m89LjB3iSO3mgx/kDDJRjDNTZ2VRushApI3ClBjOr0DN/qzlDZiSQVF
+n8pICwzQClUtA4oFKogH5n3ApdKgbFJSYYQ4YGmkjVrr7or/8nqw4nYzDzdRyptj3lowrQPNIP/qABMT
+F/xNeoL/iqzIYu924KmMR1zhPYHnKDezIPIBGeEVfvR9H1IR2gxxMyL66aGMx2T+hgHNzH+EGkKmmYVp
+OakwEBx8+NHRwb6XMKlwTpFHaDeTBQHTwRCIwF2WsMRWtUCNec5aEinNdKqqogKmsMhfr0jLTkFAP4k6
+lSL3kyfxOQa6mRn6itqsV1JN83k95DWrqysl+AthblY882rLChnGvCznCs/YgFl/zRrLVXTOImCgtLSl
+Dg+sOAVMAArqqGGGtT08YAL6CJRWIgQdm86dyqg+zY2A5jmLOpkQN3/JopItyRI+O9zbjkdJLFBoYnK9
+Rrn0JGIBNtvfe3u9fcpb4DxwatHf37LoTj36rbfbpy1wXr8Bu22ZWzeg1w3zWj327kPLfHwDeseiTxy3
+Egl4mPIoBAbPDh9T+7B+Jf+Y8CjQsW1qIqwELZURdAy8T8lGn9S7CZC1ogpxJsjSS3wvRaUh7lPueIDe
+qWd4dzGK4sMMW2zUxGJJTcObJDhHWE2YDhSZA4M0iuCZjPJY3zEqHnV7tMQznLTPWZQiJIxLZWTgBRsl
+EXbog5ZEvD6sUBV12u0oDlg0jJXuvLP+zvoKETF5Cj5MBRthB1bGKE7HyFdaIHhwZgGaM7FiOnxul78J
+tQLvkxS/EPIGyfALEbWJnc8cP05RTp7J6F5vs5nKIstbmdM70FtMeD6A5msWW+1M1kaKZN7Wis6aMKlV
+LuX4BHw4PtloWDy5tUlEZ2gmpmXBRt+BjbaZXyiI3pCpg7F4KuMEpZ54AYuipuVtkSi3KoF+NNhxkWLZ
+j8p+R+pNhds9yIo5PsMJWVk1g1oNbSyCsuP99006xQPIwU6x4Ti3UT+n/6xFYlSJb7dJJVfApGSTOTO2
+COJxZZ6mzSyqOwPfOHQNnOMTZ74Hkxrw6VExBjBSWC+kjvn4nEUntWshAm8Qyy4Lhs1zyth5ocWyQqZx
+DmMcDFwozURAbt1hGhcXZlSQ9Z6O944OjkxKNd2NJaJCjy3+OXy2WlKYR9DGz9I6Nyk1I4DNYj6YNM8X
+1M7mvkzSe0mqhs1sEzlzKR6+A2vFtuK6pQ/dxYFEoeQs4j/H8KltgH4m9HnMRdN5g9pwUZGLxNU1UHdd
+84Hq2+MixIuDQdO577hm0XfehPvg3HegAyQS1pb0Vu0qq7x2LyZNt2zqGYRG+yoFUCurkOUNq6DLASVh
+1npv3Xmrmn1YMdtBdigz+DmVPqwcsYmhWblNK25XxXk56ytbcF33rdhYAquGfcM4YkIOK+0VWKvKIphH
+sKoo2swXzhhHWiIbcXE6K6IM2ywygxbKc5R3VE4BI9TDOGzBeMiDITChxigVjLkewpGlPUKhoXuOQpu9
+coA06XJlD9E0MkQ2a4KIEw0oLgIEdsFjRdOaiDVIZKGd8pCNMCzPS1zYhJPxWKFUlczJc5Ge2VnHmro8
+WSzPFRQBoXPp79Emmfu5P6E5oAW73a0dYCKEne7jbq+bC1AGljFSx4B+HE6IiwCxHs5bSUn0xJhl8Lu9
+3lM4R9lfMPm7PQMqxPkD3Nxhb26CZmoiAuCaMjWWuVW5+7PzpJULTKKd1gVE/GxBBYQxqmodWMl5Iq5m
+saUsu7cn6D6hBQepppcbJpOcqnRhTnP7s+j9Gw+j5XlziwzdIw/0I9zLPHEvtw2mRcMeIgtRqg5MgaaH
+3JqT/AVm4MMUnK0gwEQ7HXA0Xug2UmVkBeXAbKOQxwXXHcgm1T3BtWEfZUstl90qNWcvuRTaGUo6u8k9
+6vYcGl4W4ZTPtQib387ijuIvzZAUpjw8xZF4YbDIDDx2tmOhad3kRIdmQoclScQDRtnQfq5iUZlbyBOe
+qaOlXXheXTl0SlTgAxszrm3HsdaRJLd0zmsSlRef0bLNK+moLpQkUZAKUUREgOrMMX97sHyDUH+LQFLc
+6giWXx649axEXze9Wrts+tiDN9lorw5ahvn25ZAnfY03KWzgQ+6k17xT1IcGmrvCuAGD2BIKHEMPL/SO
+hVSJ+ulgYGgcpwSaKliAkfFL5wYAoL0mQmhqmeJitKYQxgLNNJ2iqbg8cGSrR49q7CgJiGHR6bYF1vk7
+s37Nzxfr2WfTaGzBNItFB8i8Yq4rDkOx6oBIR32UJSJbTzOJFfiZhnJUe1c4rgubPqwvWkkCIy6wZFIR
+D7C53iI9rlcc3t+Vr7db4DgLI2sRiTlusmIN3lygJU9ZXdQUaofkdhsY9CMmzqxVKEK7/5jgLpEb3zPN
+vAjFqR7C5vIC5zzHJlHMwvkqMvx2IDZu2qhlJ01ZgpHxZmNybtJVX1SZ9qKw8m+0dfPtKmzxN6uFTjhG
+Ya5wo/HNTIu1VNNNjk82bjoTUejK5DM1mJ0VasNjImDOOYbRptDdSu79FGzqubfUaKx/pcp8fRWFP3A9
+LfmoeeOZLL9SnDUaxQReja+Na16araz3mEHguxwmai+v8zvEuTv1aTFldUxPseZV+3pncS8koAszmH2b
+fJxVhsD/DwBJf63mPRsAAA==
 `,
 	},
 
 	"/generator/template/ts/objs.gots": {
 		name:    "objs.gots",
 		local:   "generator/template/ts/objs.gots",
-		size:    1212,
+		size:    2806,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5xSz2sTTRi+z1/xkEOTLl+Te+GDr5+NVShatHopRSabd5Mxm9l1ZtYahgHBihasFGw9
-9KAnoVBoexK0/jlN0j9DZtMkG6se+hLC7LzP88zz/qgFAQuw3hYakYgJQqNFkhQ31ESjh3KqEpPwVJRn
-YGEiDRdSg8cxTJvQ5IZDG5WFJlOEBgnZQqapCSFzwFTVaGhSz0VImgVYuEmwAJenXwYf31ycfx3ufx68
-3bv4/n7slAUYZfrvXvf3jpfW7vZ3dofHp5dnr4b7R4Odl/0fB8P9o+Hh9uDgbLB7Mjz/MPi03T85vPi2
-w4IaY7UaSGZdzaxdgOKyRajW/QWcY/QiTZTJAbC2eo93yTlYBgAF/G1BcTMnjBJj4L/+/JjHGTn3z4RE
-sumhjll7dazVRi01vZRmjCxzw9f9ZcGMkIZUxEO6gaNFWAujveRU3DlrRQR6huoqb1CM0urS//XVJw/q
-a/Wl9fpyybmNzdzrRO5aEQvOMZbLVFdIVu9wfSvpdhNZVypRvsIgYPA//JdyxbuwxTzC8Ue+PeQvkTSe
-UmgYgtq48iiToRGJRJenBbqvoTJR8CXmHmYMzC+ioo0SsnU9+zBr5D12bv6qkVGiUInJoEM9v9IT8THA
-h4gwfbXa5vr+llxTSUrK9Cod6s1jbm7K3OhQb7NI96G3hAnbGKFtcfDe4gqZkUvKbc6OtBgh14Sytcin
-DOfKi9cwPhSZTMlfPIFrvxSTbfAPXA34N081KeJZbP6qX3okOzLZkshtl2agjrHpcfr/B2Zxu34GAAD/
-/7DrGI+8BAAA
+H4sIAAAAAAAC/+xWXWsbRxR9n19xWIwtL/XqXSEkbq2WEKcxqduXEspodWVvtZrdzszaXSYDhaa0hqYE
+6vQhD+1TIRBI8lRo059jSfkZZVbaD8m2YvrSUjoIaZi599xz55zZVdv3mY/9w0hhEMWESOGABEmuqY9e
+jo1UJjrhabSxEBYmQvNIKPA4hj4k9LnmUFpmoc4koUeROECmqI9IFAE1qlZQJI+ikBTzsfV3BvPx5uWv
+k5++PXv92/T0l8l3j8/++KFkynzMdsbffzN+/Hx779b45NH0+cs3r76enj6bnHw1/vPJ9PTZ9OnDyZNX
+k0cvpq9/nPz8cPzi6dnvJ8xvM9Zug0Q2UsyYLUguDghB1y3A2mItGiDYoVRS6BoqFntxEg7fS0YjEhqe
+h6Ca3+xXkd48n2JFBcg8aCVCmSNcIfoyTaQu6MGY4EM+ImthGAA02L4fUdwv6JYb5yjPNhaLAsAK6iXW
+BfTfjtbMFzWBsoXrbv4JjzOy9p1mpGvCncB82m7PrKbzlBYE2uGa77vFf16ksvpdQclAoZbNkV7s+WrC
+FUAXCfBvVPQtlG+pOzyt5b9NubUdGHw6pLwDY7S6TbkTEsF8Yu392cZstRTaWizCL4EaU/BIdZQIHlt7
+o/BQUczt0BcIdnmPYni72+92dz+7193rbu93dzyXqtW2lDxfrmjMrNBFbGb17lGYSRUdOXoPILI4nped
+/1xyZA2jl15YS9C53jAR1tFassvayIWsJUu2eQDTDGpsV507B2JtVD1AaiEu6Oxa2XYVd6MDQUckry02
+h3MNraZzKR4s22QNmMpB9V2KhCY54CH9N56DW/9fjqtdjsoRbrJlLWPGzNX+gERxyonoSplI5V4Xvs/g
+PriZcslHMMYzRRNBScyznrUIy7zizwq5fCS9zynUDH67NN0gE6E7MneOd3iakrS2VaV2UMFudtBSWkbi
+wJjgo6znVpW1m3OLDhKJVkwaQ8oRibp6GeBGNECNHRxydfdY7MkkJanz1pDyTayv15nOIveb6W6o40iH
+h5hFG3bpzWiOkCvChjGFxWDtRudciBuSdCbFUn1wBWNq4bHwXlyG6NOAZ7Feie99LIYiORYoJPUWQi1j
+9bT+viSz6Zm/BgBwXEOB9goAAA==
 `,
 	},
 
 	"/generator/template/ts/service_axios.gots": {
 		name:    "service_axios.gots",
 		local:   "generator/template/ts/service_axios.gots",
-		size:    2218,
+		size:    2925,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5xV728URRj+vn/FmwvJ/ch1j5pI8M4jqYpSI7ShGEmMH6a7790N7s0sM7OFZp0ExIIk
-BZrQELVVMIoh8qOaKNJC5Z/ZvTs+8S+Y2dn7QYuJcT7tzPu+zzzv87xzV6tUnAqc6lAJLRogUAltZCiI
-Qh8Wl6EYCq44CWkxS0Ob5XGmCGUSWoIzhcyHmflZ8LiPoDpEwTkuPodzVHVAdRACuiiIWIYiOU+5LFbN
-ocAWF1gFqooSBJ6NqEDfFudphgplUpEgQB8oy6BCwc+gp3IuY6bZ1VTCOUGVQmbSTy2HuOAJGg6zs5xQ
-8CXqowQCi0RSDyJJ2ggtLmwLJAiAMB+6ZBkYog/EPxNJ1UWmgHgeFz5lbVAcZIgebVFvSGnYhM1kPkiq
-IqIoZ04Fpv77ciow2Lrbu3Ulefq4v3679/VasnN9aIJTARtJV1fStfvp1Wv9+1uD3y711+/NzM/2v/sq
-efpT/87Fl89W0+3Hye7z/vq9/oMHyZOrvVvb6c7NTNiXz1Yh3bjTe/jzi80Lg18uJs+/H2xdzELpw2/S
-zXvJzvUXP273Nx4lTx6OL7y8YrEt6iviDrbuWqZ56o1fezfWkr83LL2Z+VnLML2909t8MGb46IcX3670
-Lq2kl/9Mb2wNLu1aPr07271rj9KVv5Ldm5aH/Tah379MnlxL11YHF1aT3c30yk5v44/e+rZTqTm0G3Kh
-IOujCjHMmI95wbtUImgzqN3hZDWGybEDABDHgrA2wgG1HGIVDixyHkC9CaU2qtks8T2iiOlYgvt+xDxj
-qixrnVdP2UrQupqfIPO1doaXurU4dt8NiJQnSBe1nls8M8lhNMUfi6AKKAQXxwjzAzNnY4gOBiGKYsNx
-logwo2vSoQmFjlJhvVabfusNd/rQYXd6+k330MH64YOHDxYajoPns0taOWtYQPWOrS1FIqiDVIKydjlX
-YgwbiaDhaKdWg0iiFdVxsla9YSNGonFbMKW1zbBijoXS2hZ2UXW4b6oKIZeqkFWYCG0Bns3UXkCxRD08
-rnxw50IrMxQ+OHpqnDwJ08YRilU8S8gUNPEM8qjZGe8mELXep0scu9acUkgE6cq6OZllYaRMrdblOuSz
-9HYcu3ORGkXgC2C4hOJIrmGACiakheakv6Vc4SoU4ngspdaF7CTnUCg3MijjtMdZixoUi25WQQnCZIuL
-7kmUIWcSC1CHT0e97AuXfKJIeQLBLIEqEgxMqDEK6M+qo+8OEh+FUaJ4euokno1QKvSnPqGqU6xD8fTx
-j44pFeaBon0MuuE4E+DZ3LhxnJumtRm6KsQxbQHDkZfWSK2t9HGMgUStY7B70Lm91VyM8oii6xHldUoo
-BDSP7OmvVoOOeUZonxSIXIzXifDKozNw4yt02VUdZCWBcv8dtAUl8/R5y8C7RktoNptQtN4X92pulhLL
-rzkd2m0h4MOFuRNuSITE0hC4nEu7d+Ut5NPpCpQ8WLKWA5GwZ1rL+zA0ZCJCCcv/wmvfDebvbpLXPkjn
-1Z3zv9B02fwCZd5nj/yfAAAA///dBXwSqggAAA==
+H4sIAAAAAAAC/9xWbW8Txxb+vr/iyIpkO3LW5EoXcW2CboB7RSogEQEVqeqHye6xPbCeWWbGCdZ2JSgN
+FClAJCLUNmmhaqlQeUkrtZQEUv6MX8In/kI1M7v25oUKtd+6Xzw758xzXp5njrc8OuqMwtkGlVCjAQKV
+UEeGgij0Ya4N+VBwxUlI88YNrZfHmSKUSagJzhQyHyZnpsDjPoJqEAULXFyEBaoaoBoIAZ0TRLQhTy5T
+LvMlvSmwxgWWgKq8BIGXWlSgbw8nbjoVyqQiQYA+UGagQsEvoKeSXIaZmtBUwoKgSiHT7mfbIc56goap
+t/EJBZ+nPkogMEck9aAlSR2hxoUtgQQBEOZDk7SBIfpA/AstqZrIFBDP48KnrA6KgwzRozXqpSmlRVhP
+5oOkqkUU5cwZhbH3f5xR2F5/2Lt3o/PyeX/lfu/z5c7m7ZQEZxSspbu02F1+3L15q/94ffuna/2VR5Mz
+U/2vPuu8/K7/4OrbV0vdjeedrdf9lUf9J086L2727m10N++axr59tQTd1Qe9p9+/Wbuy/cPVzuuvt9ev
+GlP36RfdtUedzdtvvt3orz7rvHg6DHh90WJb1B3N3V5/aDNNXO/82Luz3Pl91aY3OTNlM+ze3+ytPRlm
++OybN18u9q4tdq//2r2zvn1ty+bTe7DRu/Wsu/hbZ+uuzcOutennTzsvbnWXl7avLHW21ro3Nnurv/RW
+NpzRskObIRcKTB0liGBSL2YEb1KJEGuhNlNlVVPnyAEAiCJBWB1hRLVDLMHIHOcBVCagUEc1ZRyPE0V0
+xRLc/7eYp0mVxThOTo/ZkxDHpWQHmZ+x0hq4x3izydn/hODiFAlDFAP7fqadOGnybjmK3Om5C/I0aWIc
+Z8oA1KdPEOYHlNWjSEc8QeSsEkialNXjuATSvBwjQZDgwhC3gUGIIl91nHki9L3AcyKACcg1lAor5fL4
+f/7ljh885I6P/9s9eKBy6MChA7mq4+BlE76WtARmUR21ZwstEVR0SMrqxaTNQ9iWCKpO7JTL0JJoGXMc
+R7fKEjFschyb7RFTn+bEkGJ6pfkAdzq0ZEDiqSufRTGPIlO8jiTN5phMd6GJqsF9M4tAoAw5k3oqCP1G
+fDu8aqi8RorLBYy4xzEU6JmRk1mb4HMB9y5qMpEpyOXAHaz/6w88c0meGEgcCAOZ+lOE9IxRw+6mR5Ey
+ggDXyqIQEkGasmIMtklTLGwpvYzjYgUmZZt5UwoFmQvQ/CouDg+9p1tq4H4k4U6gagmW0dDhfdFL8A6Y
+gt4/d+YkuNrJZliCXBS5J5QKTyk/jnNGtiP7XgeIon0NiZKLVSfpUCAxjv9phCVD7B0UwSfAcB5FSlWA
+CjK3DyYg0/uqcdGX3OOsRo3VbOknpwRhssZF80xyH3JQgY8Gie8xF3yiSDGDkJGKNlUHhvjj0mDdQOKj
+0PXmz4+dwUstlAr9sQ+pauQrkD9/6qTWRGLIx0YWjCsz0Y5yvx3HQ6y0dfY3O3jjqpNVrhkybhQpfpIv
+oICh7vSo0gKjtUyEVKEJom1WcRDW9YjyGgUUAiaO7Kq/XIaGHsRoh/JgtuzXpB1jW8P9jTsw7HXRVQ1k
+BYFyb3a0BgX9Z8VrOjFXswQTExOQt2rJ72ZTP0q099lNhWQh4IPZ6dNuSITEQgpcTCjY/STFJ7J2BUoe
+zFsxAZHvGCHFPVAxGBaggEWI3i+Q/k7LprcH0tn55vwltMw8YnbS6A/ksTh2/hgAA7wYRW0LAAA=
 `,
 	},
 
 	"/generator/template/ts/service_fetch.gots": {
 		name:    "service_fetch.gots",
 		local:   "generator/template/ts/service_fetch.gots",
-		size:    1570,
+		size:    2645,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/4xT3W4bRRi936f4ZFXyj9x1gkQVnCZS+FPNRW0p5gHGu5/tCeuZZWbWwVpGaghuQUpI
-JCIkmogWoaKKogYkKCQl8DJe27niFdDOrO2kcIGvdj3nO+fM+c5WSiWnBM0uldCmAQKV0EGGgij0oTWA
-fCi44iSkeQNDi/I4U4QyCW3BmULmw0ajBh73EVSXKNjm4gPYpqoLbVRe15y2uYA7zWYDIkk6KDO6hZiZ
-phK2BVUKGVAGzUGIm56gocrQBhMK3qc+SiDQIpJ6ltDwGxckCIAwH3pkAAzRB+JvRVL1kCkgnseFT1kH
-FAcZokfb1EsZt9BTIPDDiAq0SOaDpCoiinLmlODm//85JZiePhl/9WD08sXk6NH4s8PR+RezHJ0S2JNk
-b5gcPks+3588O53+tDs5errRqE0efjp6+d3k8c7ff+wlZy9GF39Njp6aDJOdh2l6FrkguT+0eIu8Ftj0
-9IlVz6AHP4wPDkd/HlvJjUbNciWPzscnPy5Un39z+fVwvDtM7v+aHJxOdy8uT+5Nv98ZPz4b7z9Phr+N
-Lr68/PZscpw9p0c/fzL6fT853Jve2xtdnCQPzsfHv4yPzpxSxaG9kAsFsQMAEMeCsA7CDTUIsQw3WpwH
-UF2DQgdVzQDfJoqkl5DgvhsxL81eFrXOpm/aSdC6nP2DzNfa0WkPe5B3K3HsvhUQKe+SHmpdb23J/Orc
-w7xs74ugDCgEF3cI84O0DguKLgYhivyq4/SJSBuWwmENcl2lwmqlsvzGa+7yrRV3efl199ZSdWVpZSm3
-6jj4kRFpZ65hE9WbdrYQiaAKUgnKOsUsiQVtJIJVRzuOuZ03856msrgJ3NTacSoViCTaL8qZ66R1v11j
-TZNoPVLpw3pBouhTD2eyZeih6nJ/8R4SQXqyCnayWIWG4D0q8XZGAR8Dwz6K9cxwgAqu3APWroZZyK5T
-hkx3pldcdcy0QBUJZq2ncZQhnjvKN+qbzXwZWtwfVOG9zfpd12rQ9qBgbRZBF13VRVYQKGFt5ukKc+be
-FSh50McU525JzgrFooHqouuRVByF+E+Ca21IUbO5+XJsdRe11NruzEym+zI1fid9MwG69dDWF7T+Vzvi
-2LUVLcwWEcdujYU2fK2vLCSO3Xqk5ievLibzb2pwnaMMr4yuF3JxvOiY1rky5OZOcrNSmCubT8v07p8A
-AAD//6nV5A0iBgAA
+H4sIAAAAAAAC/9xVXW8TRxe+319xZEXyh5w1eaUXUSdGTYlLXAFJifkB491je8J6ZpmZDbXMSlAaaKVQ
+IjWqVECFqqJCpSKt1NIGmvbP+CNc9S9UM7NrO1GCetOb+sa7c57znDnP+dhSoeAUoN6mEpo0QKASWshQ
+EIU+NLqQDQVXnIQ0a2BoUR5nilAmoSk4U8h8WFytgcd9BNUmCq5zcRWuU9WGJiqvbaxNLmC5Xl+FSJIW
+yoRuEsx4UwnXBVUKGVAG9W6Ia56goUrQBhMKvkF9lECgQST1LKHhN7cgQQCE+dAhXWCIPhB/PZKqg0wB
+8TwufMpaoDjIED3apJ5mXEdPgcBrERVokcwHSVVEFOXMKcDsP/85BTjYfTr88m7/9cvRzuPhp9v9V5+n
+OjoFsJbB1uZg+/ngs3uj57sHP94e7TxbXK2NHnzSf/3t6Mmtv37fGuy97O//Odp5ZjQc3Hqg1bPICcmd
+TYu3yEOCHew+tdET6P3vh/e3+388tCEXV2uWa/D41fDRD5OoL75+89Xm8Pbm4M4vg/u7B7f33zy6efDd
+reGTveG9F4PNX/v7X7z5Zm/0MHnWpp8+7v92b7C9dXBzq7//aHD31fDhz8OdPadQcmgn5EJBzwEA6PUE
+YS2EGdUNsQgzDc4DKFcg10JVM8AloohOQoL7fsQ8rb3Mx3HiPWs9IY6LyQkyf8pKm+Ce450OZ1UhuLhI
+whDF2H6c6TBPrPu5A1m31Ou5K411eYl0MI6z8+M0xv36YYSie0UERUDNt0yYH1DW6vX0HZaJXFMCSYey
+VhwXQZqXcyQIkkgwidTGIESRnXecDSJ0Q+MVEUAFMm2lwnKpNPfO/9y502fcubn/u6dPlc+cOnMqM+84
++JG5UDMRCdZQvWd9c5EIyjokZa18IvyENhLBvBM7jhHTC4g0OeoiuOfGb7Nx7DilEkQS7QDrl/PVehGW
+q4tLZjSWqheq9aqZGJRKgtRLQLURQiJIR+rp1W/XtEzJZYrmhKs2irH9g7WVS9DgftcZZ6Lnd6HG6qZF
+ViKlH85OJ1VMYpQhRWmpLqJqc3+ceBlWBe9QiQsJBdwAhhsoziaSBKiAMqrKcNnmUGNUQQV60EmYJqwQ
+zxsf2oTc1GmlUoHs+Wo9CzduwNFzrdSxBqtcNi2N/kWmNEdbS+ec5pq3F4gBA4lTnjoDV+sHFaOla9On
+zW7usKNj/gSqSDBbU0uvCfKuaiPLCZRQOTtFnqATIV2BkgcbqHHuuuQsl89b7rzrEU2IQhxLcGhCNMpO
+yXHjeMKYJmOTxht3sF0nk1URx7axTUTd1Ga1GCrTA+5KaFcKJEh9jzUUGyimBlZ3uzSHszI9TboideIC
+ZtwlDAV65uM19WyYGwH3rupEkCnIZMAdP7/rj5GZ5BKmpqkiyNRbGVIfs7COboFeT9kJdu3myqWTog1W
+gRoL7UDEcb4Mi7LLvJpCQRoBmn/FxcIEvRKpMTytbFLVyVJbOJa9CCfQ5PT5lcsXwNUge8MiZHo9d1mP
+ivLjOGM6ZOakFpl5W4/MO4lCgcQ4/q8VLN1qx2t7dMklpfL+nSJNpGZWRP0N0N+OvwcA4YbTsFUKAAA=
 `,
 	},
 
 	"/generator/template/yii2/Module.gophp": {
 		name:    "Module.gophp",
 		local:   "generator/template/yii2/Module.gophp",
-		size:    1375,
+		size:    1524,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5RTXW/aMBR996+4qpBCETTvYbCPatNWiVJBN6la0GSSG/Dm2J7t7ENR/vtkJ4QQqKrl
-heTec8+xzz28eq32ihBBczSKJghleXNPc1y7j6qaElIYhCfGpv7lL2Pxb9zGKzRKCoPH6pYajN9JaY3V
-VH0SFnVGE5wSEo5GBEZAFYNcpgVHSDFjglkmBSScGkNgFBL/BosagX8sitRA3FI3DZYrjjkKa+BcjJQE
-AMALumcE5Rsm9qiZTWVSNcXQ/6piy1kCg0QKqyXnqO9bD2YQnNoQH1EmmJL/VskKkfjrumsPr32rPqsH
-UY3CRlHdnLb1J8aiaECVmsx14/dknkmdUwszOKwgij4sV4u3j9/u1sv75my9aYP2VuZKCufb8GsLcU+g
-8WeBxgYwm8Npy7f9WnwzPi7fT0SR743PZxTVxjl1mdJDqFKcJdSZEn43UnhscFC4M1I8eJLgnH5zQREF
-3XK8lfIHwy+Us9Qze9KMcoPPjxidvTzQkwxQa6k/UpFy1C8b1w/Tvh408fsuzbOKm+vOVgfNMMxgYPfM
-TOY7tMPTA3UiFJ+moAcct3SdkUPJhW7HjEV9yGRFLmZ6e/gfDp1OP9y19g7tZ80XVNCd45vMaZquCo79
-NJblBAYG9S+WoLMsmt2s6y/vYFWdoTUVO4QWtUC7l6npAa8elutHKMsudVXdNHupqiu3pqt+P6SKhUfM
-+EwbRQodpc24zk5rV0X+BQAA//9/+Ld9XwUAAA==
+H4sIAAAAAAAC/5RUbW/aMBD+7l9xqpBCEZTvYbCXbtNaibYq3aRqqSaTHODNsT3b7EWW//tkh6SBgKrl
+C8nd8+LcPeTVa7VRhAhaolE0R3Du4oaWuAgP3k8I2RqER8Ym8eYvY9lvXGb3aJQUBp+rS2oweyelNVZT
+dSUs6hXNcULIeDAgMACqGJSy2HKEAldMMMukgJxTYwgMxiTewbxC4B+LojCQNdK7BisVxxKFNdA1I44A
+AETDcA3AvWFig5rZQuZ+VxzHX7VdcpZDL5fCask56ptmBlNI9seQPaNMMiH/7bLaijy+bnjt/nlsVWeN
+IKpR2DStmpOm/shYmvaoUqOZ3s17NFtJXVILU6hXkKYfb+/nbx++XS9ubybkGNugvZSlkiLMrf+1gYQr
+0fhzi8YmMJ3Bfiu241piM3tefmSkaewNuxxFtUFdsbqSEUKV4iynYSjj70aKiE1qh2sjxV0USbryT0cc
+UdAlx0spfzD8QjkronIUXVFu8DTF6NXLhAPLBLWW+hMVBUf98uAOw7SpiCb70JY56fh03tpqb0eGKfTs
+hpnRbI22v3+gVoSy/RQcAIeNXItSl0Lo1sxY1HUmPTma6WX9P+wHn8NwV95rtJ81n1NB10FvNKNFcb/l
+eJhG50bQq74SV+/T6cUC9S+WYxyf9x2opmKNUKPMEUTPVL0gkE5P6bS+AsG2336ESDo/7T5Hu5HFofnZ
+3e3iAZxrH8D7i10UvD8LyThzrnlb78fO7R0kVmr4sGOPogDvXyo/DatANzv05N8ANfmCYPQFAAA=
 `,
 	},
 
 	"/generator/template/yii2/RequestHandler.gophp": {
 		name:    "RequestHandler.gophp",
 		local:   "generator/template/yii2/RequestHandler.gophp",
-		size:    373,
+		size:    452,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/4xPwUrEMBS85yvewYMWbD9gBT3qQQXXYy+hGW2hec3mJaKE9++y6VpQPOzpMW9mmJmb
-2zAGY9h6SLADqJT2yXrsj0B1Z0yWv8/eLw6z7IwZZitCLzhkSLq37GZEwmcCO6FxxdL/5oshIirlmqLl
-d1C7R/yYBrSPSOPiRLUKuqaplxq6CzZaT2tqX0pNPfah9oFDTq9fAap0EXHYLBEpR/7X85zTZjrpu3rf
-Mg9pWvhnrurlOZlXtE6qrTuafJjhwYlGRFRGT5PBTtWo+Q4AAP//qCrWrHUBAAA=
+H4sIAAAAAAAC/4xQuU7EMBTs/RWjiAIi4XzAIoG0FQVQLGUaK3mQSPaL8cEhy/+OnAuBKNaN7TnsN3Nz
+awcrBCtD3qqOkJJ8VIZO5ZLzQYjo/4KtmXrS/iBEp5X3hT2WQ5HkDPoMxL3HoLjX5Hz7m08CAFK6hlP8
+SpAncu9jR/KBwjD1PudZ0NT1LvwYwwB5nIwhDiufkh6ZVgwVAFSoUFeQqwI/DxD3O4g7q5wyWEK0KXXb
+bJD3bGN4/rIlxoWjt93iKETH/3qeYthNq76Z95fIXRgn3trL+fKcP6+wNFRW02A0VtOccSBHM7MVsKTK
+4nsAmfmh78QBAAA=
 `,
 	},
 
 	"/generator/template/yii2/controllers/ApiController.gophp": {
 		name:    "ApiController.gophp",
 		local:   "generator/template/yii2/controllers/ApiController.gophp",
-		size:    1195,
+		size:    1198,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/2xTTY+bPBC+8ytG0UpA9CZ7hze0aVW1PbRdtb1UyypyzGSxRGyvPeSjyP+9whASkviC
-PfPM1zMP/7/TpQ4CybZoNeMITTP/zrb4q304l3MlyaiqQmPTIKjtDWCrCqxs6l1/hOguRyHyPa7zj0N0
-D1Dqr1L5k1GkllqkQcArZi0stThDAQ+EsrBwNgVNAACgjdgxQnhYlUwWPmtnr9eV4LCpJSehJAgpKIq9
-qwtszwOVws6yUygsQOIe8qtxfuJbjZa+dKAoTn286+o8Tqdduik074Us0QgqFHe98fFuM2ss2U4oY287
-GlywAM0MSkqSC3g6IMUGIs/UCg/Cko0med40aDnTCKP+82VNZd/9JI7PxcYFn0NWU4mSBGekTPgCC3ge
-YdsT+pohLLIbni7KJImHtd4o/m+U5OU8ghtuBqk28qKZE8eencYw+Yow/4ZUqsI6d5dU5j9NQ4KqngHn
-bgk2+AaLVpZJ8sC0nmWmW286grSWXg6dmvOmGUaC+Vepa/p91L7CbeQs83Jrn7PsFemDKo5PzLCtjeK7
-8B2rRMEIr5K1IrjS6CzrWXcuOsVfqcIHCmmJSY5qc3eAHzUNE8RwJQmD9n5Ll6vyIFIrZgw7XoLOW6XS
-qL3n8PR3559RomHVpwNH3a4rmvT56KgR1AbCYbwQhOTKGOQ0n8RjQaAsnAtc8C8AAP//YJBkmqsEAAA=
+H4sIAAAAAAAC/2xTzW7bPBC86ykWRgBLwWfnbn1WmwZF20PbAO2liIKAodYRAZlkyFUSl+C7F6RkWVKs
+C/9mf2Z29P8HXeskkWyPVjOO4Nz6B9vjr3DwvuRKklFNg8bmSdLad4C9qrCxeXz6I0S3OQhRvuJjeTNE
+9wCl/ipV3hpF6lqLPEl4w6wNOW/CJiT2HvCNUFYWTuGJSwAAtBEvjBAuHmomq5i2u28fG8Fh10pOQkkQ
+UlCaxacuMHwXVAu7Ko6hsAWJr1DO+Di3/toBumbSLI8ZfFfp6vIyrnAJ7qOQNRpBleK+v7w6284j1uxF
+KGPf9zQ8wRY0MyhpsxnB8wEpdpBGsR7wTViy6aIsnUPLmUaYMCivW6p7DossOxWbFrxbspZqlCQ4I2WW
+97CFuwk2fMtYcwnb4p1SozKbDT+OL83+myS5P1Hww84gtUaOmjlqDADgnGHyCWH9HalWlfX+rKgsLs6R
+oKZXIExrLrDBZ9gGZ242F0zrVWHwuUVL+QQSbnpDdIYunRsowfqb1C39PuiRH8aRqyIaLhxXxRPSJ1Ud
+bplhe5tmZ+EvrBEVI5wlCyaYuXRV9Kp7nx7jZ66IgUJaYpKj2p0l8LOlgUEGM0sYtOdbGo8qgkg9MGPY
+YQw6TZVqo16jhscfvPyCEg1rPr9x1GFc6aLPRweNoHawHOgtQUiujEFO60U2NQTKyvvEJ/8GAIw1m+Su
+BAAA
 `,
 	},
 
@@ -605,63 +773,77 @@ hgHrC9Uv8WWqkM0OV6tUpT4CAAD//zSBgW8zAwAA
 	"/generator/template/yii2/handlers/RequestHandler.gophp": {
 		name:    "RequestHandler.gophp",
 		local:   "generator/template/yii2/handlers/RequestHandler.gophp",
-		size:    241,
+		size:    309,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/0yOMU4EMQxFe5/CBQUUzAUGCUEFBQgBDdI03sSwI2XibJwUYPnuiKw0ovR/tt+/uS3H
-Apk21kKB0QynZ9r4bUzuM0DXc3xPyv/RsknkpPNY+BD5EVleqjS5K+sMQAdtlULDkEgVX/nUWdsD5Zi4
-ggEiotk1VspfjNMTt6NEdR9gPy79kNaAnz2HtkpGs1HP/fJsX8zG/78Qp8dcenv/LuyOF5VPV/Ou4Rzd
-weE3AAD//+j458PxAAAA
+H4sIAAAAAAAC/1yPwUrEMBRF9/mKy+BCF+YHKojOyoUi6EboJk2fttjkxSZF9PH+XTIdyzC73HOTy8nN
+bRqSiS5QTs4TRGCfXKCXQ1JtjFnyiu9dptOqDdzTlJvDhTfmX+b2eebCd2lsjHFdLrPzBX5yOUPE7uuh
+LqgaMQAgco3ZxQ+CfaQycJ9Vt+J7LAPsnkOgWDbeTew/jxC7ynawJ68o9se0CaSlm0aP9yX6MnKsKqvF
+5fqDVsT/q8E+xLSU159EqriY6euqOdtW8zcAMDXyzDUBAAA=
 `,
 	},
 
 	"/generator/template/yii2/models/enum.gophp": {
 		name:    "enum.gophp",
 		local:   "generator/template/yii2/models/enum.gophp",
-		size:    146,
+		size:    273,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/zyLsQrCQBBE+/2K+QHzA1EsRCu1tLJZc4sKlzW4OVCW/Xe5A9O8GZh56+30mKiY4PTd
-Hflm172WsaEnGjKbwb3lmUdBVxkB+cyiyVCP5AQA7iu8We+C7vCUnAwRbRheajPc/+6m9gvnIhH9ooqm
-CAr6BQAA//8rGSPnkgAAAA==
+H4sIAAAAAAAC/1yOsW6EMBBE+/2KET3+ARKlQEmVpEyVxuBVQLENOhvdnVb77ydz3EnQzGhszc57eZuH
+mZbE+Lq2n7ZLv+9xCas0RCLnMQ8w7RQCx6wq0vmp/98yqgpGlUQ4OtSq1HubEkRW/7aBYYqqgi+Zo0so
+l0kIAERqnGz8Y5iPkb1LUH1+HIa39/06ANwJHi2Obkv9FFMuJMV3JK8QMT/WL6zaHJpKtwEAH0gZaREB
+AAA=
 `,
 	},
 
 	"/generator/template/yii2/models/error.gophp": {
 		name:    "error.gophp",
 		local:   "generator/template/yii2/models/error.gophp",
-		size:    1999,
+		size:    2847,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5RU0W4aOxB991fMXSFlkQI/wIWKKNu+0DZq81IlETLLAK4We2ubhsTyv1d4s4vxegPZ
-h0TYc86cOeOZ/z+Vm5IQTreoSpojGAPDb3SLP90va0eE7BTCLyFehXi8k0KLaclGhOQFVQqMcf8PiAoH
-1gLuNfKlgjr68Ya9ZlIKme1zLDUTHNi2LHCLXHtRX1EpukZiCACAMQOQlK8Rhp8ZFksF1rqLUgqNucYl
-9IxxOQ8qawjypbWkCtwtCpbDasfzKidnOqVS0hfoSVSl4Ar7FdD9fTfr4WMrSJlSqNMG/5A0GpKnft9j
-qtnYCvAPDGd0gQUks+lNNpv/yO6y6X12m3jkh6+nN0wNJg0ljMHpTfujk7iVkEjzDXToAKo8b0JRnjCm
-vi9+Y65heEs1vX8pEQJFlaptCWPg+Hza7hpjbaivRg0mznNPS1fcX1qwJdUYZzp15eEJxg42itaFhcJo
-FTGW4AW1uNxr8k8tuSDfxfZGGv4Bm0N0bXf8cZ7DdrWgo8aI9HjiCFvL1fapJfG7jtk+iv/oQP/3NtFB
-Oa1Z1hspnl13moX1BTlKWjRbLU2uGvwVMAVcaMA9UzrxPO2urDqLL72gXoV63uRKjYm+Nmtb69laMMbl
-9IYysCzW2WBOOrqwPlEV8krUO8lb9KOT6o9+hORazN+WYZy3urys8e8OaRDnvWUYTyKDcxR2fcngnOG7
-PjMY7ZN+7aAl/wIAAP//fzbP1M8HAAA=
+H4sIAAAAAAAC/9xWX0/bMBB/96e4RZVIJJov0LUTjG6aBhvaeJkAVW56pR6pncUuUKz77lOSJuSPM+jE
+XpYHUOy73/3ud5e7vn2XrBLGJF+jTniEYC2EX/gav+dvRCPGNhrhh1KPSl2dp8qoo0SMGLP2XpgVhO/V
+eo3SEFk7j1V0u3sHz4OQiFmLcgFDIhbFXGuwNv+fhSgCARHgg0G50FDCXx2Lx2maqnT6EGFihJIg1kmM
+GXDN6gy15jfILAMAsHYIKZc3COEHgfFCA1F10eK6O28SBgAoSJdeKBe7tyRVBiODCxhYm/POpGnaFYab
+eSwiWG5kVPCWwvg8TfkWBinqREmNQeGY//0j8+wRS/CF1mj8yv/Sszb8jFsi7zoIakAlmFhC+Emf8YSo
+cTcwK6GHkyoDGENOzQ9GDbulSpFHK3CHBK5hcItbGE9qarR51LgI/XX+EyMD4Qk3/GKbIBB1jAdmncAY
+JN43m6T0IWrTLL2Gk1zlGpc+uzseiwU36EZqinOZ5XgN49x15MwNY41EeyC1eqeDV/Vb+RBzxcxExV8Q
+nvI5xuCdHh1PT2ffpufTo4vpiffviv4fF/t1Cv2aRSZifyWvo957yNz2LuV2zp7nXPsq0JOig7kz7og9
+r2n3lJj7rmdwP3Hfd1q/2Y3rVjadSW1WqbrPa1NttI8oMeVxtfZ876DyPwChQSoD+CC08WqS9mdWnDnZ
+tvPVaGZVLN/a2g4p1pe15ejp9iBRZ68TQb77iWqfaktKV8FbX09PdW4abNu4KZpNKjvwo4YqteXeAjdq
+tpuQbtzi8mUNUft0uVzsJAW/K2HQcnrq92zP5iFna574FUt/cBeArXK9G06eeI+ADjvpB4e9y8T5a6HJ
+oI3WD+Zqjz2A63kcvmRs7M2zMRa6J0HZJ8R+DwDQdT0wHwsAAA==
 `,
 	},
 
 	"/generator/template/yii2/models/message.gophp": {
 		name:    "message.gophp",
 		local:   "generator/template/yii2/models/message.gophp",
-		size:    1963,
+		size:    5974,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5RUUW/aPBR996+4X4TUIBX+AB9MTGV7YVu19WVqK2TCBTwF27PN2s7yf5/iNCFxnELz
-0Arb5/jcc+/x/x/kXhJOD6glzRCshfFXesAf/pdzE0KOGuGnEH+FeLhVwoi5ZBNCspxqDdb6/wWixIFz
-wA4yxwNyo6ECPHxBrekOiSUAANaOQFG+Qxh/YphvNDjnN6QSBjODGxhY6wkLCRUE+cY5Uh48rnOWwfbI
-M8MEB8aZSalS9AUGCrUUXOOwBPq/b95afGwLKdMaTVrj75NaQ/I4HDaYKja2BfwN4yVdYw7Jcv5xsVx9
-X9wu5neLm6RBXnwDs2d6NKspYQpebzqctM5thUKa7aFHB1Dd8CYU1RDG9Lf1L8wMjG+ooXcvEiFQVKo6
-SJgCx6d2LyuMc6G+CjWaec8bWvrO/aE521CDcaa2K/ePMPWwSbQuzDVGq4ixBBPU4fLT1Fx15IL7LrY3
-0vB32ByiK7vjw3kO29eCnhoj0uMXR9g6rnZXHYnv9WT7JP69gf7vNdFBOZ0sm70ST7479YP1GTkqmi+e
-M5SFijS5qvFXwDRwYQCfmTZJw9P+ysq1+KMX1KvRrOq7Umuj0+Zc5+11Dqz1dzZCGVgW62yQk54u7Fqq
-Ql6F5qh4h37Sqv7kR0huxOr1MYzzlpuXNf7NkAbnGrMM01kkOCdh15cE5wzf9ZlgdFeGlYOO/AsAAP//
-ZvO2hqsHAAA=
+H4sIAAAAAAAC/9xYW2/bNhR+1684FQzUAhJhD8MwxHWKbHG2YE5TZFmBoS0MWjq2uVKkStJuPIL/fdDV
+si5xnSgvy0MMiTyX7zufSB6+eRuvYoeTCFVMAgRjwH9HIvwzfbJ25DhrhfC3EP8K8em9FFpcxHTkOMZ8
+o3oF/q8iipBra42ZMxF8yZ/BdcG31jEGeQin1joBI0qBMelvEiILBNYCjWKGiZWCIsKnG1SKLNExDgCA
+MacgCV8i+FcUWajA2nKglkj+fj8bAIAso8IKeZg/xVJoDDSGMDAmTSrBvT8vm7ieMxrAYs0DTQUHyqke
+EinJFgYSVSy4Qi8zTP8/mnnyRxcwpEqhHpb2H11j/D9wa6372fMqjgpndAH+tbohsbV7YwO9our0vEQA
+Y0hTG3qjvXkLIZEEK2gPCUTB4AtuYXxeYaOeRyUXqm7n/2Cgwb8kmtxvYwRrG5MHOophDBy/7SugsLG2
+nmZhdXqeslzJpWvehjAaEo3tnvbJ+Zhg/Azj1HTUig2ZQmuP8FTTTsNfqbfizzptMRNS8Sv4UzJHBu70
+4pfJdHY3eT+5uJ9cui9X9P9xsfspdJ9FttZ5Er0t9T6C5rp1QXfr2nPItKsCHRBbMm+NO3IOc9p8a532
+sY6Fe5f7Mat1XiUuNAyFBP82TpwRBv4tR7EA/w6DtVR0g8mq/m7NGJmzSkE8r772v8oX/xo3jXVfr6T4
+lla63B9/Q46SsMlDgGkaQ/d1af8aqErTxAeqtFspUBdP1c3lQ0ZOwlNtdLCBs/FjE+gCBhv/Dr+uqcSw
+jhajWG9fBqzMQx6JdbDxfyfqWmN0t2a4V+xBINZcwxg6SgRvIZ3RMnIGP4xaIt1QnkRqHADySG/AmMos
+a5/Ny4ooYKgU6BXhde9Ak9/j+bohD4+hOM/jkIc+UURCYhUFeXgyikd08IGwNTaEUDmjHVJuucsac2AT
+r7syJlsys827ESg9RVub7tObJMtH9uisym37clqm1Lwitvb9/qnfYVNu1rotW7T9zt2zob0DuHby6xfX
+UiLRKPc12Bs0IfOqTZEv9SqHmj+0mA1YNjSGUmjlgcGdbzUq11qlJUM+nBOFP/04CzEQIeY0eV6ht2g+
+y+dlIyfg/nV/dfqzW0hu9LRClVC6ypUj2Okwm99v1dRKyFrVdoF6leX3od2p8yXQMsGXdYn2D/Y90Rpl
+5/ryKpa4nEVEB6uhMfEqvsMlPlQMrT0p1rDewIcCs/NOGhf0CiHOovWEG78mCK6EjIgGFyNCmdvFwIIy
+jXK2IbL8pq6up/eTu9mHi+n15cX9ZDa5ubieejAej2FBmMI+VZDQQDikOQIJQ4lK9Vf9S1xQjuEtZ9tO
+BXQ0I2dnVKUnx2Hf5S9QZ8f6rmboOSTYZxwvqs1I9q61wai3KAr1rMSYHSiKa5/sxilbwtvbRmsb92zW
+Qr6kV7rrWvfT1qPVGt6Ohmq5l23dr0S9lrzhfrTHSuU+ruZci1l+qdHuNxv8vh6uImfCw5xSGDYp9GpG
+uxY1uRpLQ84iEg/LLIeDjQemxLo5Pd/lPQJ70oDvnXTe/7Re8O1nUPfW7SzBWetXy0bVOx56UyTjMfA1
+Y/A2+zlruSzYUdGdZ5uMj8jjcJDn8nngY/cKPVvnvwEAuS7ul1YXAAA=
 `,
 	},
 
@@ -718,6 +900,7 @@ var _escDirs = map[string][]os.FileInfo{
 		_escData["/generator/template/go_client.gogo"],
 		_escData["/generator/template/markdown.gomd"],
 		_escData["/generator/template/php_client.gophp"],
+		_escData["/generator/template/spring_duration.gojava"],
 		_escData["/generator/template/spring_service.gojava"],
 		_escData["/generator/template/spring_struct.gojava"],
 		_escData["/generator/template/ts"],
@@ -0,0 +1,311 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	"github.com/yoozoo/protoapi/generator/data"
+	"github.com/yoozoo/protoapi/util"
+)
+
+// API is the data model of the proto files, the same the output plugins get
+type API struct {
+	Services []*data.ServiceData `json:"services"`
+	Messages []*data.MessageData `json:"messages"`
+	Enums    []*data.EnumData    `json:"enums"`
+}
+
+// LoadAPI returns the data model of all the files, the imported ones included
+func LoadAPI(files []*descriptor.FileDescriptorProto) (*API, error) {
+	var names []string
+	for _, file := range files {
+		names = append(names, file.GetName())
+	}
+
+	ext := newExtensionDecoder(files)
+	messages, enums, err := getMessages(files, JSONNamingOriginal, ext)
+	if err != nil {
+		return nil, err
+	}
+	// the enums keep their full names like the messages, fixMessageName only renames them for the generated code
+	fixMessageName(messages, nil)
+	for _, enum := range enums {
+		enum.Name = strings.TrimPrefix(enum.Name, ".")
+	}
+
+	services, err := getServices(files, names, messages, ext)
+	if err != nil {
+		return nil, err
+	}
+	return &API{Services: services, Messages: messages, Enums: enums}, nil
+}
+
+// Change is a difference between two versions of an API
+type Change struct {
+	Breaking bool   `json:"breaking"` // the clients or servers of the old version do not work with the new one
+	Message  string `json:"message"`
+}
+
+type differ struct {
+	changes []*Change
+}
+
+func (d *differ) breaking(format string, args ...interface{}) {
+	d.changes = append(d.changes, &Change{Breaking: true, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *differ) compatible(format string, args ...interface{}) {
+	d.changes = append(d.changes, &Change{Message: fmt.Sprintf(format, args...)})
+}
+
+// Diff returns the changes from the old version of the API to the new one. The breaking changes are the ones
+// of the wire format and of the JSON API: removed or renamed fields, changed field numbers and types, removed
+// enum values, removed methods and routes, changed error types. The additions are compatible changes
+func Diff(oldAPI *API, newAPI *API) []*Change {
+	d := new(differ)
+	d.diffMessages(oldAPI.Messages, newAPI.Messages)
+	d.diffEnums(oldAPI.Enums, newAPI.Enums)
+	d.diffServices(oldAPI.Services, newAPI.Services)
+	return d.changes
+}
+
+func (d *differ) diffMessages(oldMsgs []*data.MessageData, newMsgs []*data.MessageData) {
+	newMap := make(map[string]*data.MessageData)
+	for _, msg := range newMsgs {
+		newMap[msg.Name] = msg
+	}
+	oldMap := make(map[string]*data.MessageData)
+	for _, msg := range oldMsgs {
+		oldMap[msg.Name] = msg
+		if newMsg, ok := newMap[msg.Name]; ok {
+			d.diffFields(msg, newMsg)
+		} else {
+			d.breaking("message %s removed", msg.Name)
+		}
+	}
+	for _, msg := range newMsgs {
+		if _, ok := oldMap[msg.Name]; !ok {
+			d.compatible("message %s added", msg.Name)
+		}
+	}
+}
+
+// diffFields compares the fields by number, as on the wire, and by name, as in the JSON API
+func (d *differ) diffFields(oldMsg *data.MessageData, newMsg *data.MessageData) {
+	findField := func(fields []*data.MessageField, match func(*data.MessageField) bool) *data.MessageField {
+		for _, field := range fields {
+			if match(field) {
+				return field
+			}
+		}
+		return nil
+	}
+
+	for _, old := range oldMsg.Fields {
+		name := oldMsg.Name + "." + old.Name
+		byName := findField(newMsg.Fields, func(f *data.MessageField) bool { return f.Name == old.Name })
+		byNumber := findField(newMsg.Fields, func(f *data.MessageField) bool { return f.Number == old.Number })
+		field := byName
+		switch {
+		case byName == nil && byNumber == nil:
+			d.breaking("field %s removed", name)
+			continue
+		case byName == nil:
+			d.breaking("field %s renamed to %s", name, byNumber.Name)
+			field = byNumber
+		case byName.Number != old.Number:
+			d.breaking("field number of %s changed from %d to %d", name, old.Number, byName.Number)
+		}
+
+		if !old.IsMap() && !field.IsMap() && old.DataType == field.DataType && old.Label != field.Label {
+			d.breaking("label of %s changed from %s to %s", name, fieldLabel(old), fieldLabel(field))
+		} else if oldType, newType := fieldType(old), fieldType(field); oldType != newType {
+			d.breaking("type of %s changed from %s to %s", name, oldType, newType)
+		}
+		switch {
+		case old.Oneof == field.Oneof:
+		case old.Oneof == "":
+			d.breaking("field %s moved into oneof %s", name, field.Oneof)
+		case field.Oneof == "":
+			d.breaking("field %s moved out of oneof %s", name, old.Oneof)
+		default:
+			d.breaking("field %s moved from oneof %s to %s", name, old.Oneof, field.Oneof)
+		}
+		switch {
+		case !old.Optional && field.Optional:
+			d.breaking("field %s made optional", name)
+		case old.Optional && !field.Optional:
+			d.breaking("field %s no longer optional", name)
+		}
+		// the JSON key of a renamed field changes with it, unless set by json_name
+		if old.Key != field.Key && byName != nil {
+			d.breaking("JSON key of %s changed from %s to %s", name, old.Key, field.Key)
+		}
+		if !old.Deprecated && field.Deprecated {
+			d.compatible("field %s deprecated", name)
+		}
+	}
+
+	for _, field := range newMsg.Fields {
+		byName := findField(oldMsg.Fields, func(f *data.MessageField) bool { return f.Name == field.Name })
+		byNumber := findField(oldMsg.Fields, func(f *data.MessageField) bool { return f.Number == field.Number })
+		if byName == nil && byNumber == nil {
+			d.compatible("field %s.%s added", newMsg.Name, field.Name)
+		}
+	}
+}
+
+// fieldLabel returns the label of the field as written in the proto files, ie singular or repeated
+func fieldLabel(field *data.MessageField) string {
+	if field.Label == data.FieldRepeatedLabel {
+		return "repeated"
+	}
+	return "singular"
+}
+
+// fieldType returns the type of the field as written in the proto files, ie repeated int32 or map<string, Item>
+func fieldType(field *data.MessageField) string {
+	switch {
+	case field.IsMap():
+		return fmt.Sprintf("map<%s, %s>", field.KeyType, field.DataType)
+	case field.Label == data.FieldRepeatedLabel:
+		return "repeated " + field.DataType
+	}
+	return field.DataType
+}
+
+func (d *differ) diffEnums(oldEnums []*data.EnumData, newEnums []*data.EnumData) {
+	newMap := make(map[string]*data.EnumData)
+	for _, enum := range newEnums {
+		newMap[enum.Name] = enum
+	}
+	oldMap := make(map[string]*data.EnumData)
+	for _, enum := range oldEnums {
+		oldMap[enum.Name] = enum
+		newEnum, ok := newMap[enum.Name]
+		if !ok {
+			d.breaking("enum %s removed", enum.Name)
+			continue
+		}
+
+		values := make(map[string]int32)
+		for _, value := range newEnum.Fields {
+			values[value.Name] = value.Value
+		}
+		oldValues := make(map[string]bool)
+		for _, value := range enum.Fields {
+			oldValues[value.Name] = true
+			if number, ok := values[value.Name]; !ok {
+				d.breaking("enum value %s.%s removed", enum.Name, value.Name)
+			} else if number != value.Value {
+				d.breaking("number of enum value %s.%s changed from %d to %d", enum.Name, value.Name, value.Value, number)
+			}
+		}
+		for _, value := range newEnum.Fields {
+			if !oldValues[value.Name] {
+				d.compatible("enum value %s.%s added", enum.Name, value.Name)
+			}
+		}
+	}
+	for _, enum := range newEnums {
+		if _, ok := oldMap[enum.Name]; !ok {
+			d.compatible("enum %s added", enum.Name)
+		}
+	}
+}
+
+func (d *differ) diffServices(oldSers []*data.ServiceData, newSers []*data.ServiceData) {
+	newMap := make(map[string]*data.ServiceData)
+	for _, service := range newSers {
+		newMap[service.Name] = service
+	}
+	oldMap := make(map[string]*data.ServiceData)
+	for _, service := range oldSers {
+		oldMap[service.Name] = service
+		newService, ok := newMap[service.Name]
+		if !ok {
+			d.breaking("service %s removed", service.Name)
+			continue
+		}
+		if service.CommonErrorType != newService.CommonErrorType {
+			d.breaking("common error of %s changed from %s to %s", service.Name, orNone(service.CommonErrorType), orNone(newService.CommonErrorType))
+		}
+		d.diffMethods(service, newService)
+	}
+	for _, service := range newSers {
+		if _, ok := oldMap[service.Name]; !ok {
+			d.compatible("service %s added", service.Name)
+		}
+	}
+}
+
+func (d *differ) diffMethods(oldService *data.ServiceData, newService *data.ServiceData) {
+	newMap := make(map[string]*data.Method)
+	for _, mtd := range newService.Methods {
+		newMap[mtd.Name] = mtd
+	}
+	oldMap := make(map[string]*data.Method)
+	for _, old := range oldService.Methods {
+		oldMap[old.Name] = old
+		name := oldService.Name + "." + old.Name
+		mtd, ok := newMap[old.Name]
+		if !ok {
+			d.breaking("method %s removed", name)
+			continue
+		}
+
+		if old.InputType != mtd.InputType {
+			d.breaking("input of %s changed from %s to %s", name, old.InputType, mtd.InputType)
+		}
+		if old.OutputType != mtd.OutputType {
+			d.breaking("output of %s changed from %s to %s", name, old.OutputType, mtd.OutputType)
+		}
+		if old.ServerStreaming != mtd.ServerStreaming {
+			d.breaking("server streaming of %s changed from %t to %t", name, old.ServerStreaming, mtd.ServerStreaming)
+		}
+		oldError, newError := old.Options[data.ErrorTypeMethodOption], mtd.Options[data.ErrorTypeMethodOption]
+		if oldError != newError {
+			d.breaking("error type of %s changed from %s to %s", name, orNone(oldError), orNone(newError))
+		}
+
+		oldRoutes := routes(old)
+		newRoutes := routes(mtd)
+		for _, route := range oldRoutes {
+			if !util.IsStrInSlice(route, newRoutes) {
+				d.breaking("route %s of %s removed", route, name)
+			}
+		}
+		for _, route := range newRoutes {
+			if !util.IsStrInSlice(route, oldRoutes) {
+				d.compatible("route %s of %s added", route, name)
+			}
+		}
+
+		if !old.Deprecated && mtd.Deprecated {
+			d.compatible("method %s deprecated", name)
+		}
+	}
+	for _, mtd := range newService.Methods {
+		if _, ok := oldMap[mtd.Name]; !ok {
+			d.compatible("method %s.%s added", newService.Name, mtd.Name)
+		}
+	}
+}
+
+// routes returns the routes of the method as HTTP verb and path, ie GET /users/{user_id}
+func routes(mtd *data.Method) []string {
+	var result []string
+	for _, binding := range mtd.Bindings {
+		result = append(result, binding.HttpMtd+" "+binding.Path)
+	}
+	return result
+}
+
+func orNone(name string) string {
+	if name == "" {
+		return "none"
+	}
+	return name
+}
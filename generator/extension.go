@@ -0,0 +1,372 @@
+package generator
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	"github.com/yoozoo/protoapi/generator/data"
+)
+
+// the option messages extended by the custom options
+const (
+	fileOptionsType      = ".google.protobuf.FileOptions"
+	messageOptionsType   = ".google.protobuf.MessageOptions"
+	fieldOptionsType     = ".google.protobuf.FieldOptions"
+	enumOptionsType      = ".google.protobuf.EnumOptions"
+	enumValueOptionsType = ".google.protobuf.EnumValueOptions"
+	serviceOptionsType   = ".google.protobuf.ServiceOptions"
+	methodOptionsType    = ".google.protobuf.MethodOptions"
+)
+
+var errTruncated = errors.New("truncated option value")
+
+// protoapiOptions are the options declared in protoapi_common.proto by extended option message and field number,
+// they are matched by number and name so that a copy of the file in a proto package works too
+var protoapiOptions = map[string]map[int32]string{
+	methodOptionsType: {
+		51006: data.ServiceTypeMethodOption,
+		51007: data.ErrorTypeMethodOption,
+		51016: data.PathMethodOption,
+		51018: data.SunsetMethodOption,
+	},
+	serviceOptionsType: {
+		51008: data.ServiceCommonErrorOption,
+		51009: data.ServiceAuthOption,
+		51017: data.ServiceBasePathOption,
+	},
+	fieldOptionsType: {
+		51002: data.FormatFieldOption,
+		51003: data.RequiredFieldOption,
+		51004: data.MinFieldOption,
+		51005: data.MaxFieldOption,
+		51010: data.MinLengthFieldOption,
+		51011: data.MaxLengthFieldOption,
+		51012: data.PatternFieldOption,
+		51013: data.MinItemsFieldOption,
+		51014: data.MaxItemsFieldOption,
+		51015: data.DefinedOnlyFieldOption,
+	},
+}
+
+// extension is a custom option declared in the proto files
+type extension struct {
+	name  string // full name, ie acme.owner
+	field *descriptor.FieldDescriptorProto
+}
+
+// extensionDecoder decodes the custom options with the extension, message and enum declarations of a request.
+// The options are not known to the plugin at compile time, so they are kept in the wire format by the proto package.
+type extensionDecoder struct {
+	extensions map[string]map[int32]*extension            // by extended option message and field number
+	messages   map[string]*descriptor.DescriptorProto     // by full name with the leading dot
+	enums      map[string]*descriptor.EnumDescriptorProto // by full name with the leading dot
+}
+
+func newExtensionDecoder(files []*descriptor.FileDescriptorProto) *extensionDecoder {
+	d := &extensionDecoder{
+		extensions: make(map[string]map[int32]*extension),
+		messages:   make(map[string]*descriptor.DescriptorProto),
+		enums:      make(map[string]*descriptor.EnumDescriptorProto),
+	}
+	for _, file := range files {
+		var scope string
+		if pkg := file.GetPackage(); pkg != "" {
+			scope = "." + pkg
+		}
+		d.addExtensions(scope, file.GetExtension())
+		d.addEnums(scope, file.GetEnumType())
+		d.addMessages(scope, file.GetMessageType())
+	}
+	return d
+}
+
+func (d *extensionDecoder) addExtensions(scope string, fields []*descriptor.FieldDescriptorProto) {
+	for _, field := range fields {
+		extendee := field.GetExtendee()
+		if d.extensions[extendee] == nil {
+			d.extensions[extendee] = make(map[int32]*extension)
+		}
+		d.extensions[extendee][field.GetNumber()] = &extension{
+			name:  strings.TrimPrefix(scope+"."+field.GetName(), "."),
+			field: field,
+		}
+	}
+}
+
+func (d *extensionDecoder) addEnums(scope string, enums []*descriptor.EnumDescriptorProto) {
+	for _, enum := range enums {
+		d.enums[scope+"."+enum.GetName()] = enum
+	}
+}
+
+func (d *extensionDecoder) addMessages(scope string, messages []*descriptor.DescriptorProto) {
+	for _, message := range messages {
+		name := scope + "." + message.GetName()
+		d.messages[name] = message
+		// extensions may be declared inside a message
+		d.addExtensions(name, message.GetExtension())
+		d.addEnums(name, message.GetEnumType())
+		d.addMessages(name, message.GetNestedType())
+	}
+}
+
+// decode returns the custom options set on an option message of the extendee type. The option map
+// holds the string form of the protoapi options by option name, the extension map the values of all
+// the options by full option name, so the options of your own only clash there with the full name.
+func (d *extensionDecoder) decode(extendee string, options proto.Message) (data.OptionMap, data.ExtensionMap) {
+	optionMap := make(data.OptionMap)
+	// nil option messages fail to marshal, there is nothing to decode then
+	b, err := proto.Marshal(options)
+	if err != nil {
+		return optionMap, nil
+	}
+
+	extensions := d.extensions[extendee]
+	// full names of the protoapi options set
+	own := make(map[string]bool)
+	values, err := d.decodeFields(b, func(number int32) (string, *descriptor.FieldDescriptorProto) {
+		if ext, ok := extensions[number]; ok {
+			if protoapiOptions[extendee][number] == ext.field.GetName() {
+				own[ext.name] = true
+			}
+			return ext.name, ext.field
+		}
+		return "", nil
+	})
+	if err != nil {
+		// the options failing to decode are skipped, the others are kept
+		log.Printf("invalid custom option in %s: %v\n", extendee, err)
+	}
+	if len(values) == 0 {
+		return optionMap, nil
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if own[name] {
+			optionMap[name[strings.LastIndex(name, ".")+1:]] = optionString(values[name])
+		}
+	}
+	return optionMap, data.ExtensionMap(values)
+}
+
+// optionString returns the string form of a decoded value, message and repeated values are encoded as JSON
+func optionString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool, int64, uint64, float64:
+		return fmt.Sprint(v)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
+
+// decodeFields decodes the fields of a wire format message, lookup returns the name and declaration of a field number.
+// The unknown fields are skipped. The fields whose value fails to decode are skipped too and reported in the error,
+// the result holds the other fields then. The result is nil if the message itself is malformed.
+func (d *extensionDecoder) decodeFields(b []byte, lookup func(int32) (string, *descriptor.FieldDescriptorProto)) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	var invalid []string
+	for len(b) > 0 {
+		key, n := proto.DecodeVarint(b)
+		if n == 0 {
+			return nil, errTruncated
+		}
+		b = b[n:]
+
+		// n is the size of the value, start the offset of its payload
+		var start int
+		wireType := int(key & 7)
+		switch wireType {
+		case proto.WireVarint:
+			_, n = proto.DecodeVarint(b)
+		case proto.WireFixed64:
+			n = 8
+		case proto.WireFixed32:
+			n = 4
+		case proto.WireBytes:
+			size, m := proto.DecodeVarint(b)
+			start, n = m, m+int(size)
+			if m == 0 || n < m {
+				n = 0
+			}
+		default:
+			return nil, fmt.Errorf("unsupported wire type %d", wireType)
+		}
+		if n == 0 || n > len(b) {
+			return nil, errTruncated
+		}
+		value := b[start:n]
+		b = b[n:]
+
+		name, field := lookup(int32(key >> 3))
+		if field == nil {
+			continue
+		}
+		values, err := d.decodeValues(field, wireType, value)
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		if field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			// the last value wins for singular fields
+			result[name] = values[len(values)-1]
+		} else if entry := d.mapEntry(field); entry != nil {
+			m, _ := result[name].(map[string]interface{})
+			if m == nil {
+				m = make(map[string]interface{})
+				result[name] = m
+			}
+			for _, v := range values {
+				kv := v.(map[string]interface{})
+				m[fmt.Sprint(kv["key"])] = kv["value"]
+			}
+		} else {
+			list, _ := result[name].([]interface{})
+			result[name] = append(list, values...)
+		}
+	}
+	if len(invalid) > 0 {
+		return result, errors.New(strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
+// mapEntry returns the map entry message of a map field, nil for the other fields
+func (d *extensionDecoder) mapEntry(field *descriptor.FieldDescriptorProto) *descriptor.DescriptorProto {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil
+	}
+	if message := d.messages[field.GetTypeName()]; message.GetOptions().GetMapEntry() {
+		return message
+	}
+	return nil
+}
+
+// decodeValues decodes the value of a field, which holds several values if the field is packed
+func (d *extensionDecoder) decodeValues(field *descriptor.FieldDescriptorProto, wireType int, b []byte) ([]interface{}, error) {
+	var packedWireType int
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		value, err := d.decodeValue(field, b)
+		return []interface{}{value}, err
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		packedWireType = proto.WireFixed64
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		packedWireType = proto.WireFixed32
+	default:
+		packedWireType = proto.WireVarint
+	}
+
+	if wireType != proto.WireBytes {
+		value, err := d.decodeValue(field, b)
+		return []interface{}{value}, err
+	}
+	var values []interface{}
+	for len(b) > 0 {
+		var n int
+		switch packedWireType {
+		case proto.WireFixed64:
+			n = 8
+		case proto.WireFixed32:
+			n = 4
+		default:
+			_, n = proto.DecodeVarint(b)
+		}
+		if n == 0 || n > len(b) {
+			return nil, errTruncated
+		}
+		value, err := d.decodeValue(field, b[:n])
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		b = b[n:]
+	}
+	return values, nil
+}
+
+// decodeValue decodes a single value of a field, b holds the varint, the fixed size value or the payload of the length delimited value
+func (d *extensionDecoder) decodeValue(field *descriptor.FieldDescriptorProto, b []byte) (interface{}, error) {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return string(b), nil
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		message, ok := d.messages[field.GetTypeName()]
+		if !ok {
+			return nil, fmt.Errorf("message %s not found", field.GetTypeName())
+		}
+		return d.decodeFields(b, func(number int32) (string, *descriptor.FieldDescriptorProto) {
+			for _, f := range message.GetField() {
+				if f.GetNumber() == number {
+					return f.GetName(), f
+				}
+			}
+			return "", nil
+		})
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return nil, errors.New("groups are not supported")
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return binary.LittleEndian.Uint64(b), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return int64(binary.LittleEndian.Uint64(b)), nil
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return uint64(binary.LittleEndian.Uint32(b)), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return int64(int32(binary.LittleEndian.Uint32(b))), nil
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), nil
+	}
+
+	v, _ := proto.DecodeVarint(b)
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return v != 0, nil
+	case descriptor.FieldDescriptorProto_TYPE_INT32:
+		return int64(int32(v)), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64:
+		return int64(v), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_UINT64:
+		return v, nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return int64(v>>1) ^ -int64(v&1), nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		// unknown enum values are kept as numbers
+		for _, value := range d.enums[field.GetTypeName()].GetValue() {
+			if value.GetNumber() == int32(v) {
+				return value.GetName(), nil
+			}
+		}
+		return int64(int32(v)), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", field.GetType())
+}
@@ -2,12 +2,18 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"runtime/debug"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/golang/protobuf/proto"
@@ -22,25 +28,49 @@ import (
 	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 )
 
+// the JSON naming policies of the message fields
+const (
+	// JSONNamingOriginal uses the proto field names as JSON keys
+	JSONNamingOriginal = "original"
+	// JSONNamingCamel uses the lowerCamelCase field names as JSON keys, as the proto3 JSON mapping
+	JSONNamingCamel = "camel"
+)
+
 const (
 	googleDescriptorProtoName = "google/protobuf/descriptor.proto"
+
+	// jsonNamingParam is the generator parameter choosing the JSON key of the message fields
+	jsonNamingParam = "json_naming"
+
+	// field numbers and values missing in the vendored descriptor and plugin protos
+	proto3OptionalField    = 17
+	supportedFeaturesField = 2
+	featureProto3Optional  = 1
 )
 
+// googleAPIProtoNames are the files of the google.api.http option, its messages are not generated
+var googleAPIProtoNames = []string{"google/api/annotations.proto", "google/api/http.proto"}
+
 // createEnums create EnumData objects from the passed in enum discriptor
-func createEnums(pkg string, path string, enums []*descriptor.EnumDescriptorProto, cMap data.CommentMap) []*data.EnumData {
+func createEnums(file string, pkg string, path string, enums []*descriptor.EnumDescriptorProto, cMap data.CommentMap, ext *extensionDecoder) []*data.EnumData {
 	var result []*data.EnumData
 	for eIndex, enum := range enums {
-		var enumCommPath = path + strconv.Itoa(eIndex)
+		var enumCommPath = commentPath(path, eIndex)
 		var enumData = new(data.EnumData)
 		enumData.Name = pkg + "." + enum.GetName()
-		enumData.Comment = getCommentsFromMap(enumCommPath, cMap)
+		enumData.File = file
+		enumData.Comment, enumData.Comments = getComments(enumCommPath, cMap)
+		enumData.Deprecated = enum.GetOptions().GetDeprecated()
+		_, enumData.Extensions = ext.decode(enumOptionsType, enum.GetOptions())
 
 		for fIndex, field := range enum.GetValue() {
-			var enumFieldCommPath = enumCommPath + strconv.Itoa(data.EnumFieldCommentPath) + strconv.Itoa(fIndex)
+			var enumFieldCommPath = commentPath(enumCommPath, data.EnumFieldCommentPath, fIndex)
 			var enumField data.EnumField
 			enumField.Name = field.GetName()
 			enumField.Value = field.GetNumber()
-			enumField.Comment = getCommentsFromMap(enumFieldCommPath, cMap)
+			enumField.Comment, enumField.Comments = getComments(enumFieldCommPath, cMap)
+			enumField.Deprecated = field.GetOptions().GetDeprecated()
+			_, enumField.Extensions = ext.decode(enumValueOptionsType, field.GetOptions())
 			enumData.Fields = append(enumData.Fields, enumField)
 		}
 		result = append(result, enumData)
@@ -48,95 +78,340 @@ func createEnums(pkg string, path string, enums []*descriptor.EnumDescriptorProt
 	return result
 }
 
+// getFieldDataType returns the data type of a message field
+func getFieldDataType(field *descriptor.FieldDescriptorProto) string {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_ENUM,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return field.GetTypeName()
+	default:
+		// the scalar kinds are named as in the proto files, ie TYPE_UINT64 is "uint64"
+		return strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+	}
+}
+
+// isProto3Optional returns if the field is a proto3 optional field. The vendored descriptor predates
+// proto3_optional (field 17 of FieldDescriptorProto), so it is read from the unrecognized fields
+func isProto3Optional(field *descriptor.FieldDescriptorProto) bool {
+	buf := proto.NewBuffer(field.XXX_unrecognized)
+	for {
+		key, err := buf.DecodeVarint()
+		if err != nil || key == 0 {
+			return false
+		}
+		if key == proto3OptionalField<<3|proto.WireVarint {
+			value, err := buf.DecodeVarint()
+			return err == nil && value != 0
+		}
+		// skip the other unrecognized fields
+		switch key & 7 {
+		case proto.WireVarint:
+			_, err = buf.DecodeVarint()
+		case proto.WireFixed64:
+			_, err = buf.DecodeFixed64()
+		case proto.WireBytes:
+			_, err = buf.DecodeRawBytes(false)
+		case proto.WireFixed32:
+			_, err = buf.DecodeFixed32()
+		default:
+			return false
+		}
+		if err != nil {
+			return false
+		}
+	}
+}
+
+// isSyntheticOneof returns if the oneof is the one protoc adds for a proto3 optional field
+func isSyntheticOneof(oIndex int, fields []*descriptor.FieldDescriptorProto) bool {
+	for _, field := range fields {
+		if field.OneofIndex != nil && int(field.GetOneofIndex()) == oIndex {
+			return isProto3Optional(field)
+		}
+	}
+	return false
+}
+
+// setSupportedFeatures tells protoc which features the plugin supports. The vendored plugin proto
+// predates supported_features (field 2 of CodeGeneratorResponse), so it is written as an unrecognized field
+func setSupportedFeatures(response *plugin.CodeGeneratorResponse, features uint64) {
+	buf := proto.NewBuffer(nil)
+	buf.EncodeVarint(supportedFeaturesField<<3 | proto.WireVarint)
+	buf.EncodeVarint(features)
+	response.XXX_unrecognized = append(response.XXX_unrecognized, buf.Bytes()...)
+}
+
+// getJSONKey returns the JSON key of a message field. A json_name set in the proto file always wins,
+// otherwise the key is the proto field name, or its lowerCamelCase form with the camel naming.
+// protoc fills json_name for every field, so it is considered set when the source code info records
+// it, or when it differs from the derived name for the files without source code info.
+func getJSONKey(field *descriptor.FieldDescriptorProto, declared bool, jsonNaming string) string {
+	camelName := data.LowerCamelCase(field.GetName())
+	if field.JsonName != nil && (declared || field.GetJsonName() != camelName) {
+		return field.GetJsonName()
+	}
+	if jsonNaming == JSONNamingCamel {
+		return camelName
+	}
+	return field.GetName()
+}
+
+// getMapEntry returns the synthetic XxxEntry message of a map field, nil if the field is not a map
+func getMapEntry(msgName string, message *descriptor.DescriptorProto, field *descriptor.FieldDescriptorProto) *descriptor.DescriptorProto {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE || field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return nil
+	}
+
+	prefix := "." + strings.TrimPrefix(msgName, ".") + "."
+	for _, nested := range message.GetNestedType() {
+		if nested.GetOptions().GetMapEntry() && field.GetTypeName() == prefix+nested.GetName() {
+			return nested
+		}
+	}
+	return nil
+}
+
+// getFieldValidation returns the validation rules set by the field options, nil if there is none.
+// valueField is the value of map entries, otherwise the field itself.
+func getFieldValidation(options data.OptionMap, field *descriptor.FieldDescriptorProto, valueField *descriptor.FieldDescriptorProto) (*data.FieldValidation, error) {
+	var v data.FieldValidation
+	var found bool
+	var err error
+	getInt := func(name string) *int64 {
+		value, ok := options[name]
+		if !ok || err != nil {
+			return nil
+		}
+		var i int64
+		if i, err = strconv.ParseInt(value, 10, 64); err != nil {
+			err = fmt.Errorf("invalid %s option %q", name, value)
+			return nil
+		}
+		found = true
+		return &i
+	}
+	v.Min = getInt(data.MinFieldOption)
+	v.Max = getInt(data.MaxFieldOption)
+	v.MinLength = getInt(data.MinLengthFieldOption)
+	v.MaxLength = getInt(data.MaxLengthFieldOption)
+	v.MinItems = getInt(data.MinItemsFieldOption)
+	v.MaxItems = getInt(data.MaxItemsFieldOption)
+	if err != nil {
+		return nil, err
+	}
+	v.Required = options[data.RequiredFieldOption] == "true"
+	v.DefinedOnly = options[data.DefinedOnlyFieldOption] == "true"
+	v.Format = options[data.FormatFieldOption]
+	v.Pattern = options[data.PatternFieldOption]
+	if !found && !v.Required && !v.DefinedOnly && v.Format == "" && v.Pattern == "" {
+		return nil, nil
+	}
+
+	// the rules must fit the field type, or the generated checks would not compile
+	valueType := valueField.GetType()
+	isString := valueType == descriptor.FieldDescriptorProto_TYPE_STRING
+	isBytes := valueType == descriptor.FieldDescriptorProto_TYPE_BYTES
+	isNumber := data.IsScalarType(getFieldDataType(valueField)) && !isString && !isBytes &&
+		valueType != descriptor.FieldDescriptorProto_TYPE_BOOL
+	isUnsigned := valueType == descriptor.FieldDescriptorProto_TYPE_UINT32 || valueType == descriptor.FieldDescriptorProto_TYPE_UINT64 ||
+		valueType == descriptor.FieldDescriptorProto_TYPE_FIXED32 || valueType == descriptor.FieldDescriptorProto_TYPE_FIXED64
+	isNegative := func(i *int64) bool {
+		return i != nil && *i < 0
+	}
+	switch {
+	case (v.Min != nil || v.Max != nil) && !isNumber:
+		return nil, fmt.Errorf("%s and %s are only for number fields", data.MinFieldOption, data.MaxFieldOption)
+	case (isNegative(v.Min) || isNegative(v.Max)) && isUnsigned:
+		return nil, fmt.Errorf("%s and %s must not be negative for unsigned fields", data.MinFieldOption, data.MaxFieldOption)
+	case (v.MinLength != nil || v.MaxLength != nil) && !isString && !isBytes:
+		return nil, fmt.Errorf("%s and %s are only for string and bytes fields", data.MinLengthFieldOption, data.MaxLengthFieldOption)
+	case (v.Pattern != "" || v.Format != "") && !isString:
+		return nil, fmt.Errorf("%s and %s are only for string fields", data.PatternFieldOption, data.FormatFieldOption)
+	case v.HasItemRules() && field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED:
+		return nil, fmt.Errorf("%s and %s are only for repeated and map fields", data.MinItemsFieldOption, data.MaxItemsFieldOption)
+	case v.DefinedOnly && valueType != descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return nil, fmt.Errorf("%s is only for enum fields", data.DefinedOnlyFieldOption)
+	}
+	if v.Pattern != "" {
+		if _, err := regexp.Compile(v.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", data.PatternFieldOption, err)
+		}
+	}
+	return &v, nil
+}
+
 // createMessages create message and enum definitions from the passed in descriptor
-func createMessages(file string, path string, pkg string, messages []*descriptor.DescriptorProto, cMap data.CommentMap) ([]*data.MessageData, []*data.EnumData) {
+func createMessages(file string, path string, pkg string, messages []*descriptor.DescriptorProto, cMap data.CommentMap, jsonNames map[string]bool, jsonNaming string, ext *extensionDecoder) ([]*data.MessageData, []*data.EnumData, error) {
 	var resultMsg []*data.MessageData
 	var resultEnum []*data.EnumData
 
 	for mIndex, message := range messages {
-		var msgCommPath = path + strconv.Itoa(mIndex)
+		// map entries are rendered as native maps, not as messages
+		if message.GetOptions().GetMapEntry() {
+			continue
+		}
+
+		var msgCommPath = commentPath(path, mIndex)
 		var msgData = new(data.MessageData)
 		msgData.Name = pkg + "." + message.GetName()
 		msgData.File = file
-		msgData.Comment = getCommentsFromMap(msgCommPath, cMap)
+		msgData.Comment, msgData.Comments = getComments(msgCommPath, cMap)
+		msgData.Deprecated = message.GetOptions().GetDeprecated()
+		_, msgData.Extensions = ext.decode(messageOptionsType, message.GetOptions())
 
 		// the message itself
 		fields := message.GetField()
 		for fIndex, field := range fields {
-			var msgFieldPath = msgCommPath + strconv.Itoa(data.MessageFieldCommentPath) + strconv.Itoa(fIndex)
+			var msgFieldPath = commentPath(msgCommPath, data.MessageFieldCommentPath, fIndex)
 			msgField := new(data.MessageField)
 			msgField.Name = field.GetName()
-			msgField.Key = field.GetName()
+			msgField.Number = field.GetNumber()
+			msgField.Key = getJSONKey(field, jsonNames[commentPath(msgFieldPath, data.FieldJSONNamePath)], jsonNaming)
 			msgField.Label = field.GetLabel().String()
-			msgField.Options = getFieldOptions(field)
-			msgField.Comment = getCommentsFromMap(msgFieldPath, cMap)
-
-			switch field.GetType().String() {
-			case "TYPE_STRING":
-				msgField.DataType = data.StringFieldType
-			case "TYPE_BYTES":
-				msgField.DataType = data.StringFieldType
-			case "TYPE_ENUM":
-				msgField.DataType = field.GetTypeName()
-			case "TYPE_MESSAGE":
-				msgField.DataType = field.GetTypeName()
-			case "TYPE_FLOAT":
-				msgField.DataType = data.DoubleFieldType
-			case "TYPE_DOUBLE":
-				msgField.DataType = data.DoubleFieldType
-			case "TYPE_BOOL":
-				msgField.DataType = data.BooleanFieldType
-			case "TYPE_INT64":
-				msgField.DataType = data.Int64FieldType
-			default:
-				msgField.DataType = data.IntFieldType
+			msgField.Options, msgField.Extensions = ext.decode(fieldOptionsType, field.GetOptions())
+			msgField.Comment, msgField.Comments = getComments(msgFieldPath, cMap)
+			msgField.Optional = isProto3Optional(field)
+			msgField.Deprecated = field.GetOptions().GetDeprecated()
+
+			msgField.DataType = getFieldDataType(field)
+			valueField := field
+			if entry := getMapEntry(msgData.Name, message, field); entry != nil {
+				// map entry has key as field 1 and value as field 2
+				for _, f := range entry.GetField() {
+					switch f.GetNumber() {
+					case 1:
+						msgField.KeyType = getFieldDataType(f)
+					case 2:
+						msgField.DataType = getFieldDataType(f)
+						valueField = f
+					}
+				}
 			}
 
+			validation, err := getFieldValidation(msgField.Options, field, valueField)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s.%s: %v", strings.TrimPrefix(msgData.Name, "."), msgField.Name, err)
+			}
+			msgField.Validation = validation
+
 			msgData.Fields = append(msgData.Fields, msgField)
 		}
-		resultEnum = append(resultEnum, createEnums(msgData.Name, strconv.Itoa(data.MessageEnumCommentPath), message.GetEnumType(), cMap)...)
+
+		// oneof groups, the members are also kept in the field list above
+		for oIndex, oneof := range message.GetOneofDecl() {
+			if isSyntheticOneof(oIndex, fields) {
+				continue
+			}
+			var oneofPath = commentPath(msgCommPath, data.MessageOneofCommentPath, oIndex)
+			oneofData := new(data.OneofData)
+			oneofData.Name = oneof.GetName()
+			oneofData.Comment, oneofData.Comments = getComments(oneofPath, cMap)
+			for fIndex, field := range fields {
+				if field.OneofIndex != nil && int(field.GetOneofIndex()) == oIndex {
+					msgData.Fields[fIndex].Oneof = oneofData.Name
+					oneofData.Fields = append(oneofData.Fields, msgData.Fields[fIndex])
+				}
+			}
+			msgData.Oneofs = append(msgData.Oneofs, oneofData)
+		}
+		resultEnum = append(resultEnum, createEnums(file, msgData.Name, commentPath(msgCommPath, data.MessageEnumCommentPath), message.GetEnumType(), cMap, ext)...)
 		// msg and enum definitions from the nested messages and enums (recursively)
-		msgs, enums := createMessages(file, msgCommPath+strconv.Itoa(data.MessageNestedCommentPath), msgData.Name, message.GetNestedType(), cMap)
+		msgs, enums, err := createMessages(file, commentPath(msgCommPath, data.MessageNestedCommentPath), msgData.Name, message.GetNestedType(), cMap, jsonNames, jsonNaming, ext)
+		if err != nil {
+			return nil, nil, err
+		}
 		resultEnum = append(resultEnum, enums...)
 		resultMsg = append(resultMsg, msgs...)
 		resultMsg = append(resultMsg, msgData)
 	}
-	return resultMsg, resultEnum
+	return resultMsg, resultEnum, nil
 }
 
+// createCommentMap returns the comments of the elements of a file by source code path
 func createCommentMap(codeInfo []*descriptor.SourceCodeInfo_Location) data.CommentMap {
 	result := make(data.CommentMap)
-	var replaceSpaces = func(s rune) rune {
-		if unicode.IsSpace(s) {
-			return ' '
+	for _, c := range codeInfo {
+		comments := &data.Comments{
+			Leading:  cleanComment(c.GetLeadingComments()),
+			Trailing: cleanComment(c.GetTrailingComments()),
+		}
+		for _, detached := range c.GetLeadingDetachedComments() {
+			if detached = cleanComment(detached); detached != "" {
+				comments.Detached = append(comments.Detached, detached)
+			}
+		}
+		if comments.Leading == "" && comments.Trailing == "" && len(comments.Detached) == 0 {
+			continue
 		}
-		return s
+		var path string
+		for _, p := range c.GetPath() {
+			path = commentPath(path, int(p))
+		}
+		result[path] = comments
 	}
-	for _, c := range codeInfo {
+	return result
+}
 
-		if c.LeadingComments != nil || c.TrailingComments != nil {
-			path := c.GetPath()
-			var comment string
-			if c.LeadingComments != nil {
-				// have leading comment
-				comment += strings.Map(replaceSpaces, c.GetLeadingComments())
-				comment += " "
-			}
-			if c.TrailingComments != nil {
-				// have trailing comment
-				comment += strings.Map(replaceSpaces, c.GetTrailingComments())
-				comment += " "
-			}
-			var idx string
-			for _, p := range path {
-				idx += strconv.Itoa(int(p))
-			}
-			result[idx] = comment
+// getDeclaredJSONNames returns the source code paths of the json_name options set in a file
+func getDeclaredJSONNames(codeInfo []*descriptor.SourceCodeInfo_Location) map[string]bool {
+	result := make(map[string]bool)
+	for _, c := range codeInfo {
+		p := c.GetPath()
+		// json_name of a field: ...,2,<field index>,10
+		if len(p) < 3 || p[len(p)-1] != data.FieldJSONNamePath || p[len(p)-3] != data.MessageFieldCommentPath {
+			continue
 		}
+		var path string
+		for _, n := range p {
+			path = commentPath(path, int(n))
+		}
+		result[path] = true
 	}
 	return result
 }
 
+// commentPath appends numbers to the source code path of an element, the numbers are separated
+// by commas so paths like 4,1,2 and 4,12 are told apart
+func commentPath(path string, numbers ...int) string {
+	for _, n := range numbers {
+		if path != "" {
+			path += ","
+		}
+		path += strconv.Itoa(n)
+	}
+	return path
+}
+
+// cleanComment removes the trailing spaces of the lines, their common indentation and the blank
+// lines around the comment. protoc keeps the space after // and strips the * of block comments,
+// but for the second * of /** which is left on the first line.
+func cleanComment(comment string) string {
+	lines := strings.Split(comment, "\n")
+	if strings.TrimSpace(lines[0]) == "*" {
+		lines = lines[1:]
+	}
+	indent := -1
+	for i, line := range lines {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		lines[i] = line
+		if line == "" {
+			continue
+		}
+		if n := len(line) - len(strings.TrimLeftFunc(line, unicode.IsSpace)); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent < 0 {
+		return ""
+	}
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = line[indent:]
+		}
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
 func parseMessageDataType(dataType string) string {
 	if strings.HasPrefix(dataType, ".") {
 		return dataType[1:]
@@ -145,26 +420,35 @@ func parseMessageDataType(dataType string) string {
 	return dataType
 }
 
-func getCommentsFromMap(path string, cMap data.CommentMap) string {
-	result := ""
-	comment, exist := cMap[path]
-	if exist {
-		result = comment
+// getComments returns the doc comment of an element, its leading comment or else the trailing one,
+// and all its comments
+func getComments(path string, cMap data.CommentMap) (string, *data.Comments) {
+	comments, ok := cMap[path]
+	if !ok {
+		return "", nil
 	}
-	return result
+	if comments.Leading != "" {
+		return comments.Leading, comments
+	}
+	return comments.Trailing, comments
 }
 
 // getMessages returns the flattened message and enum definitions generated from the discriptors
-func getMessages(files []*descriptor.FileDescriptorProto) ([]*data.MessageData, []*data.EnumData) {
+func getMessages(files []*descriptor.FileDescriptorProto, jsonNaming string, ext *extensionDecoder) ([]*data.MessageData, []*data.EnumData, error) {
 	var resultMsg []*data.MessageData
 	var resultEnum []*data.EnumData
 	for _, file := range files {
 		// exclude google protobuf descriptor proto file
-		if file.GetName() == googleDescriptorProtoName {
+		if file.GetName() == googleDescriptorProtoName || util.IsStrInSlice(file.GetName(), googleAPIProtoNames) {
+			continue
+		}
+		// well-known types are mapped to native types by the generators
+		if util.IsStrInSlice(file.GetName(), data.WellKnownTypeFiles) {
 			continue
 		}
 		// create comment map for each file
 		cMap := createCommentMap(file.SourceCodeInfo.GetLocation())
+		jsonNames := getDeclaredJSONNames(file.SourceCodeInfo.GetLocation())
 		packageName := file.GetPackage()
 		// packageName for this file
 		if len(packageName) > 0 {
@@ -172,90 +456,345 @@ func getMessages(files []*descriptor.FileDescriptorProto) ([]*data.MessageData,
 		}
 
 		//enums at file level
-		resultEnum = append(resultEnum, createEnums(packageName, strconv.Itoa(data.EnumCommentPath), file.GetEnumType(), cMap)...)
+		resultEnum = append(resultEnum, createEnums(file.GetName(), packageName, commentPath("", data.EnumCommentPath), file.GetEnumType(), cMap, ext)...)
 		//messages at file level
-		msgs, enums := createMessages(file.GetName(), strconv.Itoa(data.MessageCommentPath), packageName, file.GetMessageType(), cMap)
+		msgs, enums, err := createMessages(file.GetName(), commentPath("", data.MessageCommentPath), packageName, file.GetMessageType(), cMap, jsonNames, jsonNaming, ext)
+		if err != nil {
+			return nil, nil, err
+		}
 		resultEnum = append(resultEnum, enums...)
 		resultMsg = append(resultMsg, msgs...)
 	}
+	markRecursiveFields(resultMsg)
+
+	return resultMsg, resultEnum, nil
+}
+
+// markRecursiveFields flags the singular fields whose message type refers back to the message holding them,
+// directly (ie a parent field) or through other messages. The repeated and map fields end the recursion when empty
+func markRecursiveFields(messages []*data.MessageData) {
+	msgMap := make(map[string]*data.MessageData)
+	for _, msg := range messages {
+		msgMap[msg.Name] = msg
+	}
+	for _, msg := range messages {
+		for _, field := range msg.Fields {
+			field.Recursive = field.Label != data.FieldRepeatedLabel && refersTo(field.DataType, msg.Name, msgMap, make(map[string]bool))
+		}
+	}
+}
 
-	return resultMsg, resultEnum
+// refersTo returns if the data type is the message name or has fields referring to it
+func refersTo(dataType string, name string, msgMap map[string]*data.MessageData, visited map[string]bool) bool {
+	if dataType == name {
+		return true
+	}
+	msg, ok := msgMap[dataType]
+	if !ok || visited[dataType] {
+		return false
+	}
+	visited[dataType] = true
+	for _, field := range msg.Fields {
+		if refersTo(field.DataType, name, msgMap, visited) {
+			return true
+		}
+	}
+	return false
 }
 
 // map MethodDescriptorProto to Method
-func getMethods(pkg string, path string, service *descriptor.ServiceDescriptorProto, cMap data.CommentMap) []*data.Method {
+func getMethods(pkg string, path string, service *descriptor.ServiceDescriptorProto, basePath string, msgMap map[string]*data.MessageData, cMap data.CommentMap, ext *extensionDecoder) ([]*data.Method, error) {
 	methods := service.GetMethod()
 	serviceName := service.GetName()
 	var resultMtd []*data.Method
 	log.Printf("proto pkg: %s\n", pkg)
 	for mIndex, mtd := range methods {
-		var mtdMessagePath = path + strconv.Itoa(mIndex)
+		var mtdMessagePath = commentPath(path, mIndex)
 		var mtdData = &data.Method{
 			Name:       mtd.GetName(),
 			InputType:  parseMessageDataType(mtd.GetInputType()),
 			OutputType: parseMessageDataType(mtd.GetOutputType()),
-			HttpMtd:    mapHTTPMtd(mtd.GetName()),
-			URI:        serviceName + "." + mtd.GetName(),
-			Comment:    getCommentsFromMap(mtdMessagePath, cMap),
-			Options:    getMethodOptions(mtd),
+			// the responses are streamed as Server-Sent Events, there is no way to stream the requests
+			ServerStreaming: mtd.GetServerStreaming(),
+			Deprecated:      mtd.GetOptions().GetDeprecated(),
 		}
+		mtdData.Comment, mtdData.Comments = getComments(mtdMessagePath, cMap)
+		if mtd.GetClientStreaming() {
+			return nil, fmt.Errorf("%s.%s: client-streaming and bidirectional streaming methods are not supported, only server-streaming ones", serviceName, mtd.GetName())
+		}
+		mtdData.Options, mtdData.Extensions = ext.decode(methodOptionsType, mtd.GetOptions())
+		if sunset, ok := mtdData.Options[data.SunsetMethodOption]; ok {
+			if !mtdData.Deprecated {
+				return nil, fmt.Errorf("%s.%s: the sunset option is only allowed on deprecated methods", serviceName, mtd.GetName())
+			}
+			date, err := getSunset(sunset)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", serviceName, mtd.GetName(), err)
+			}
+			mtdData.Sunset = date
+		}
+		warn := func(format string, args ...interface{}) {
+			log.Printf("warning: %s.%s: %s\n", serviceName, mtd.GetName(), fmt.Sprintf(format, args...))
+		}
+		bindings, err := getHTTPBindings(serviceName+"."+mtd.GetName(), basePath, mtdData.Options, mtdData.Extensions, warn)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", serviceName, mtd.GetName(), err)
+		}
+		for _, binding := range bindings {
+			if binding.PathParams, err = getPathParams(binding.Path, msgMap[mtdData.InputType], msgMap); err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", serviceName, mtd.GetName(), err)
+			}
+			if !util.IsStrInSlice(binding.HttpMtd, mtdData.HttpMtds) {
+				mtdData.HttpMtds = append(mtdData.HttpMtds, binding.HttpMtd)
+			}
+		}
+		mtdData.Bindings = bindings
+		mtdData.HttpMtd = bindings[0].HttpMtd
+		mtdData.Path = bindings[0].Path
+		mtdData.PathParams = bindings[0].PathParams
+		mtdData.URI = strings.TrimPrefix(mtdData.Path, "/")
 		resultMtd = append(resultMtd, mtdData)
 	}
-	return resultMtd
+	return resultMtd, nil
+}
+
+// getSunset returns the HTTP-date of the sunset option, given as a date like 2019-06-30 or as an HTTP-date
+func getSunset(value string) (string, error) {
+	for _, layout := range []string{"2006-01-02", http.TimeFormat} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC().Format(http.TimeFormat), nil
+		}
+	}
+	return "", fmt.Errorf("invalid sunset %q, expected a date like 2019-06-30 or an HTTP-date", value)
+}
+
+// getHTTPBindings returns the routes of a method, read from the google.api.http option if set,
+// otherwise from the service_method and path options. The method name is the path when no path is set.
+// The parts of the options that are not supported but do not prevent serving the method are passed to warn.
+func getHTTPBindings(name string, basePath string, options data.OptionMap, extensions data.ExtensionMap, warn func(format string, args ...interface{})) ([]*data.HTTPBinding, error) {
+	if rule, ok := extensions[data.HTTPRuleExtension]; ok {
+		for _, option := range []string{data.ServiceTypeMethodOption, data.PathMethodOption} {
+			if _, ok := options[option]; ok {
+				return nil, fmt.Errorf("the %s option cannot be combined with %s", option, data.HTTPRuleExtension)
+			}
+		}
+		return getHTTPRuleBindings(rule, basePath, warn)
+	}
+
+	httpMtds, err := getHTTPMethods(options[data.ServiceTypeMethodOption])
+	if err != nil {
+		return nil, err
+	}
+	path := options[data.PathMethodOption]
+	if path == "" {
+		path = name
+	}
+	path = basePath + "/" + strings.TrimPrefix(path, "/")
+
+	bindings := make([]*data.HTTPBinding, len(httpMtds))
+	for i, verb := range httpMtds {
+		bindings[i] = &data.HTTPBinding{HttpMtd: verb, Path: path}
+	}
+	return bindings, nil
+}
+
+// getHTTPRuleBindings converts a google.api.http rule and its additional bindings to routes
+func getHTTPRuleBindings(value interface{}, basePath string, warn func(format string, args ...interface{})) ([]*data.HTTPBinding, error) {
+	rule, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid %s option", data.HTTPRuleExtension)
+	}
+	binding, err := getHTTPRuleBinding(rule, basePath, warn)
+	if err != nil {
+		return nil, err
+	}
+	bindings := []*data.HTTPBinding{binding}
+
+	additionalBindings, _ := rule["additional_bindings"].([]interface{})
+	for _, v := range additionalBindings {
+		additionalRule, _ := v.(map[string]interface{})
+		if _, ok := additionalRule["additional_bindings"]; ok {
+			return nil, fmt.Errorf("%s: additional_bindings cannot be nested", data.HTTPRuleExtension)
+		}
+		if binding, err = getHTTPRuleBinding(additionalRule, basePath, warn); err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings, nil
+}
+
+var httpRulePatterns = []string{"get", "put", "post", "delete", "patch"}
+
+// httpRuleVariablePattern matches the variables of the google.api.http paths which take a single segment, ie {name} or {name=*}
+var httpRuleVariablePattern = regexp.MustCompile(`\{([^{}=]*)(=\*)?\}`)
+
+// getHTTPRuleBinding converts a single google.api.http rule to a route. The input is sent as the JSON body by
+// POST, PUT and PATCH and as the query string by the other verbs. A body naming a single field and the
+// response_body are not supported, the whole input and output are sent instead with a warning.
+func getHTTPRuleBinding(rule map[string]interface{}, basePath string, warn func(format string, args ...interface{})) (*data.HTTPBinding, error) {
+	var verb, path string
+	for _, pattern := range httpRulePatterns {
+		if p, ok := rule[pattern].(string); ok {
+			verb, path = strings.ToUpper(pattern), p
+		}
+	}
+	if custom, ok := rule["custom"].(map[string]interface{}); ok {
+		kind, _ := custom["kind"].(string)
+		verb = strings.ToUpper(kind)
+		path, _ = custom["path"].(string)
+		if !util.IsStrInSlice(verb, data.HTTPMethods) {
+			return nil, fmt.Errorf("%s: unsupported custom method %q, expected one of %s", data.HTTPRuleExtension, kind, strings.Join(data.HTTPMethods, ", "))
+		}
+	}
+	if verb == "" || path == "" {
+		return nil, fmt.Errorf("%s: the rule has no HTTP method and path", data.HTTPRuleExtension)
+	}
+
+	body, _ := rule["body"].(string)
+	switch {
+	case !data.HasHTTPBody(verb) && body != "":
+		return nil, fmt.Errorf("%s: %s requests send the input as the query string, the body must not be set", data.HTTPRuleExtension, verb)
+	case data.HasHTTPBody(verb) && body == "":
+		warn("%s: %s requests send the whole input as the body, body \"*\" is used", data.HTTPRuleExtension, verb)
+	case data.HasHTTPBody(verb) && body != "*":
+		warn("%s: body %q is not supported, the whole input is sent as the body", data.HTTPRuleExtension, body)
+	}
+	if responseBody, _ := rule["response_body"].(string); responseBody != "" {
+		warn("%s: response_body %q is not supported, the whole output is sent as the body", data.HTTPRuleExtension, responseBody)
+	}
+
+	// {name=*} is the same as {name}, the other patterns are rejected by the path parameter checks
+	path = httpRuleVariablePattern.ReplaceAllString(path, "{$1}")
+	return &data.HTTPBinding{HttpMtd: verb, Path: basePath + "/" + strings.TrimPrefix(path, "/")}, nil
+}
+
+// getHTTPMethods parses the service_method option, a list of HTTP verbs separated by commas or spaces.
+// The methods are served with POST if the option is not set.
+func getHTTPMethods(option string) ([]string, error) {
+	var result []string
+	for _, verb := range strings.FieldsFunc(option, func(r rune) bool { return r == ',' || r == '|' || unicode.IsSpace(r) }) {
+		verb = strings.ToUpper(verb)
+		if !util.IsStrInSlice(verb, data.HTTPMethods) {
+			return nil, fmt.Errorf("invalid %s %q, expected one or more of %s", data.ServiceTypeMethodOption, verb, strings.Join(data.HTTPMethods, ", "))
+		}
+		if !util.IsStrInSlice(verb, result) {
+			result = append(result, verb)
+		}
+	}
+	if len(result) == 0 {
+		return []string{data.HTTPPost}, nil
+	}
+	return result, nil
+}
+
+var pathParamPattern = regexp.MustCompile(`^\{([A-Za-z_][A-Za-z0-9_]*)\}$`)
+
+// getPathParams returns the input fields bound from the parameters of a path template.
+// The parameters take whole path segments and refer to singular scalar or enum fields.
+func getPathParams(path string, input *data.MessageData, msgMap map[string]*data.MessageData) ([]*data.MessageField, error) {
+	var params []*data.MessageField
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.ContainsAny(segment, "{}") {
+			continue
+		}
+		match := pathParamPattern.FindStringSubmatch(segment)
+		if match == nil {
+			return nil, fmt.Errorf("invalid path %q, parameters must take whole segments and name top-level fields, like {user_id}", path)
+		}
+		if input == nil {
+			return nil, fmt.Errorf("path parameter %q: the input type has no fields", match[1])
+		}
+
+		var param *data.MessageField
+		for _, field := range input.Fields {
+			if field.Name == match[1] {
+				param = field
+				break
+			}
+		}
+		switch {
+		case param == nil:
+			return nil, fmt.Errorf("path parameter %q is not a field of %s", match[1], input.Name)
+		case util.IsStrInSlice(match[1], pathParamNames(params)):
+			return nil, fmt.Errorf("path parameter %q is used twice", match[1])
+		case param.Label == data.FieldRepeatedLabel || param.Optional || param.Oneof != "" ||
+			param.DataType == data.BytesFieldType || data.IsWellKnownType(param.DataType) ||
+			msgMap[param.DataType] != nil:
+			return nil, fmt.Errorf("path parameter %q must be a singular scalar or enum field", match[1])
+		}
+		params = append(params, param)
+	}
+	return params, nil
 }
 
-// map http method according to the method name, assume only post and get for now
-func mapHTTPMtd(method string) string {
-	isGet := strings.Contains(method, "Get")
-	if isGet {
-		return "get"
-	} else {
-		return "post"
+func pathParamNames(params []*data.MessageField) []string {
+	names := make([]string, len(params))
+	for i, param := range params {
+		names[i] = param.Name
 	}
+	return names
 }
 
 // createServices create message and enum definitions from the passed in descriptor
-func createServices(file string, path string, pkg string, services []*descriptor.ServiceDescriptorProto, cMap data.CommentMap) []*data.ServiceData {
+func createServices(file string, path string, pkg string, services []*descriptor.ServiceDescriptorProto, msgMap map[string]*data.MessageData, cMap data.CommentMap, ext *extensionDecoder) ([]*data.ServiceData, error) {
 	var resultSers []*data.ServiceData
 
 	for sIndex, service := range services {
-		var serCommentPath = path + strconv.Itoa(sIndex)
+		var serCommentPath = commentPath(path, sIndex)
 		var serData = new(data.ServiceData)
 		// the service itself
 
 		// Get all mtds for the service
 		serData.Name = service.GetName()
-		serData.Comment = getCommentsFromMap(serCommentPath, cMap)
-		mtds := getMethods(pkg, serCommentPath+strconv.Itoa(data.ServiceMethodCommentPath), service, cMap)
-		serData.Methods = mtds
+		serData.File = file
+		serData.Comment, serData.Comments = getComments(serCommentPath, cMap)
 		serData.Service = service
-		serData.Options = getServiceOptions(service)
-		serData.CommonErrorType = serData.Options["common_error"]
+		serData.Deprecated = service.GetOptions().GetDeprecated()
+		serData.Options, serData.Extensions = ext.decode(serviceOptionsType, service.GetOptions())
+		serData.CommonErrorType = serData.Options[data.ServiceCommonErrorOption]
+		if basePath := strings.Trim(serData.Options[data.ServiceBasePathOption], "/"); basePath != "" {
+			serData.BasePath = "/" + basePath
+		}
+		mtds, err := getMethods(pkg, commentPath(serCommentPath, data.ServiceMethodCommentPath), service, serData.BasePath, msgMap, cMap, ext)
+		if err != nil {
+			return nil, err
+		}
+		serData.Methods = mtds
 
 		resultSers = append(resultSers, serData)
 	}
-	return resultSers
+	return resultSers, nil
 }
 
 /**
- *	GET all the services in .proto file
+ *	GET all the services in the .proto files to generate
  *  Returns an array of service data
  */
-func getServices(files []*descriptor.FileDescriptorProto) []*data.ServiceData {
+func getServices(files []*descriptor.FileDescriptorProto, filesToGenerate []string, messages []*data.MessageData, ext *extensionDecoder) ([]*data.ServiceData, error) {
 	var resultSers []*data.ServiceData
-	var resultEnum []*data.EnumData
+	// the method input types by name, to bind the path parameters
+	msgMap := make(map[string]*data.MessageData)
+	for _, msg := range messages {
+		msgMap[msg.Name] = msg
+	}
 
 	for _, file := range files {
+		// services of the imported files are not generated
+		if !util.IsStrInSlice(file.GetName(), filesToGenerate) {
+			continue
+		}
 		packageName := file.GetPackage()
 		// create comment map for each file
 		cMap := createCommentMap(file.SourceCodeInfo.GetLocation())
-		// enums at file level
-		resultEnum = append(resultEnum, createEnums(packageName, strconv.Itoa(data.EnumCommentPath), file.GetEnumType(), cMap)...)
 		// service at file level
-		sers := createServices(file.GetName(), strconv.Itoa(data.ServiceCommentPath), packageName, file.GetService(), cMap)
+		sers, err := createServices(file.GetName(), commentPath("", data.ServiceCommentPath), packageName, file.GetService(), msgMap, cMap, ext)
+		if err != nil {
+			return nil, err
+		}
 		resultSers = append(resultSers, sers...)
 	}
-	return resultSers
+	return resultSers, nil
 }
 
 // getPackageName returns the package name from the .proto file on the command line and if java package is defined, return java package name
@@ -274,18 +813,7 @@ func getPackageName(request *plugin.CodeGeneratorRequest) string {
 
 // isPrimitiveType returns if the field type is considered primitive (ie can be translated to language/built-in of the target code)
 func isPrimitiveType(fieldType string) bool {
-	switch fieldType {
-	case data.StringFieldType:
-		return true
-	case data.DoubleFieldType:
-		return true
-	case data.IntFieldType:
-		return true
-	case data.BooleanFieldType:
-		return true
-	default:
-		return false
-	}
+	return data.IsScalarType(fieldType)
 }
 
 // findRootObject  find the root object from the command line .proto file
@@ -344,18 +872,13 @@ func buildDepGraph(rootMsg *data.MessageData, msgMap map[string]*data.MessageDat
 		var depList = make(map[string]bool)
 
 		for _, field := range msg.Fields {
-			if !isPrimitiveType(field.DataType) {
-				// make sure the message does not reference itself
-				if strings.Compare(field.DataType, msg.Name) != 0 {
-					if _, ok := msgMap[field.DataType]; ok {
-						if _, ok := result[field.DataType]; !ok {
-							pendingMsgs = append(pendingMsgs, msgMap[field.DataType])
-						}
-						depList[field.DataType] = true
+			// a message referencing itself does not depend on another definition
+			if !isPrimitiveType(field.DataType) && strings.Compare(field.DataType, msg.Name) != 0 {
+				if _, ok := msgMap[field.DataType]; ok {
+					if _, ok := result[field.DataType]; !ok {
+						pendingMsgs = append(pendingMsgs, msgMap[field.DataType])
 					}
-				} else {
-					log.Printf("Object cant reference itself: %s (in %s)\n", msg.Name, msg.File)
-					return nil
+					depList[field.DataType] = true
 				}
 			}
 		}
@@ -367,24 +890,25 @@ func buildDepGraph(rootMsg *data.MessageData, msgMap map[string]*data.MessageDat
 // findDepOrder do a topological sort to create the order of the message definitions
 func findDepOrder(rootMsg *data.MessageData, msgMap map[string]*data.MessageData) []string {
 	msgGraph := buildDepGraph(rootMsg, msgMap)
-	if msgGraph == nil {
-		return nil
-	}
 	var result []string
 	for len(msgGraph) > 0 {
 		var msgName string
 		for k, v := range msgGraph {
 			if len(v) == 0 {
 				msgName = k
-				delete(msgGraph, k)
 				break
 			}
 		}
-		// if curcular dependency found, error out
+		// circular dependency, the messages refer to each other: break the cycle at the smallest name
+		// so that the order does not change between runs
 		if len(msgName) == 0 {
-			log.Printf("Circular dependency found. This is not supported.\n")
-			return nil
+			for k := range msgGraph {
+				if len(msgName) == 0 || k < msgName {
+					msgName = k
+				}
+			}
 		}
+		delete(msgGraph, msgName)
 
 		result = append(result, msgName)
 		for _, v := range msgGraph {
@@ -409,6 +933,7 @@ func fixMessageName(messages []*data.MessageData, enums []*data.EnumData) {
 			// if not unique , append "_NNN" NNN is the current postfix value
 			if _, ok := existName[name]; ok {
 				name = fmt.Sprintf("%s_%d", originalName, postfix)
+				postfix++
 			} else {
 				break
 			}
@@ -495,15 +1020,17 @@ func filterMessages(file string, messages []*data.MessageData, enums []*data.Enu
 	return resultMsgs, resultEnums
 }
 
-//createkeyList recursively create the key list
-func createKeyList(prefix string, msg *data.MessageData, msgMap map[string]*data.MessageData) []string {
+// createkeyList recursively create the key list, the fields referring back to a message of the path are keys themselves
+func createKeyList(prefix string, msg *data.MessageData, msgMap map[string]*data.MessageData, path map[string]bool) []string {
 	var result []string
 	log.Printf("msg: %s\n", msg.Name)
-	log.Printf("msg.Fields: %s\n", msg.Fields)
+	log.Printf("msg.Fields: %v\n", msg.Fields)
+	path[msg.Name] = true
+	defer delete(path, msg.Name)
 	for _, field := range msg.Fields {
-		if _, ok := msgMap[field.DataType]; ok {
+		if _, ok := msgMap[field.DataType]; ok && !path[field.DataType] {
 
-			tmp := createKeyList(prefix+field.Name+data.PathSeparator, msgMap[field.DataType], msgMap)
+			tmp := createKeyList(prefix+field.Name+data.PathSeparator, msgMap[field.DataType], msgMap, path)
 			for _, v := range tmp {
 				result = append(result, v)
 			}
@@ -516,179 +1043,213 @@ func createKeyList(prefix string, msg *data.MessageData, msgMap map[string]*data
 	return result
 }
 
-//generateKeyList returns a list of strings use as kv store key
+// generateKeyList returns a list of strings use as kv store key
 func generateKeyList(messages []*data.MessageData) []string {
 	var msgMap = make(map[string]*data.MessageData)
 	for _, msg := range messages {
 		msgMap[msg.Name] = msg
 		log.Printf("msg.Name: %s\n", msg.Name)
-		log.Printf("msg: %s\n", msg)
+		log.Printf("msg: %v\n", msg)
 	}
-	return createKeyList("", messages[len(messages)-1], msgMap)
+	return createKeyList("", messages[len(messages)-1], msgMap, make(map[string]bool))
 }
 
-// Get method options from proto file
-func getFieldOptions(fieldPb *descriptor.FieldDescriptorProto) data.OptionMap {
-	options := make(map[string]string)
-	// create extension description
-	for field, info := range data.FieldOptions {
-		var extDesc = &proto.ExtensionDesc{
-			ExtendedType:  (*descriptor.FieldOptions)(nil),
-			ExtensionType: info.DefaultNil,
-			Field:         field,
-			Name:          info.Name,
-			Tag:           "bytes," + string(field) + ",opt,name=" + info.Name,
-		}
-
-		ext, err := proto.GetExtension(fieldPb.GetOptions(), extDesc)
-		if err == nil {
-			// add the field option to the method data
-			options[info.Name] = getStringOptions(ext, info)
-		}
-	}
-	return options
-}
-
-// Get method options from proto file
-func getMethodOptions(method *descriptor.MethodDescriptorProto) data.OptionMap {
-	options := make(map[string]string)
-	// create extension description
-	for field, info := range data.MethodOptions {
-		var extDesc = &proto.ExtensionDesc{
-			ExtendedType:  (*descriptor.MethodOptions)(nil),
-			ExtensionType: info.DefaultNil,
-			Field:         field,
-			Name:          info.Name,
-			Tag:           "bytes," + string(field) + ",opt,name=" + info.Name,
-		}
-
-		ext, err := proto.GetExtension(method.GetOptions(), extDesc)
-		if err == nil {
-			// add the service method option to the method data
-			options[info.Name] = getStringOptions(ext, info)
-		}
-	}
-	return options
-}
-
-// Get service options from proto file
-func getServiceOptions(service *descriptor.ServiceDescriptorProto) data.OptionMap {
-	options := make(map[string]string)
-
-	// create extension description
-	for field, info := range data.ServiceOptions {
-
-		var extDesc = &proto.ExtensionDesc{
-			ExtendedType:  (*descriptor.ServiceOptions)(nil),
-			ExtensionType: info.DefaultNil,
-			Field:         field,
-			Name:          info.Name,
-			Tag:           "bytes," + string(field) + ",opt,name=" + info.Name,
-		}
-
-		ext, err := proto.GetExtension(service.GetOptions(), extDesc)
-
-		if err == nil {
-			// add the service option to the method data
-			options[info.Name] = getStringOptions(ext, info)
-		}
-	}
-	return options
-}
-
-// get string representations of option value to be put into map[string][string]
-func getStringOptions(ext interface{}, info data.OptionInfo) string {
-	result := ""
-	switch info.Type {
-	case data.StringFieldType:
-		result = *ext.(*string)
-	case data.BooleanFieldType:
-		result = strconv.FormatBool(*ext.(*bool))
-	}
-
-	return result
-}
-
-// Get s from proto file
-func getFileOptions(request *plugin.CodeGeneratorRequest) data.OptionMap {
+// getFileOptions returns the java package and the custom options of the first file on the command line
+func getFileOptions(request *plugin.CodeGeneratorRequest, ext *extensionDecoder) (data.OptionMap, data.ExtensionMap) {
 	for _, file := range request.ProtoFile {
 		if strings.Compare(file.GetName(), request.FileToGenerate[0]) == 0 {
 			// check options from .proto file
 			if fileOptions := file.GetOptions(); fileOptions != nil {
-				options := make(map[string]string)
+				options, extensions := ext.decode(fileOptionsType, fileOptions)
 				// get java package from options
 				if javaPackageName := fileOptions.GetJavaPackage(); javaPackageName != "" {
 					options[data.JavaPackageOption] = javaPackageName
 				}
-				return options
+				return options, extensions
 			}
 
 		}
 	}
-	return nil
+	return nil, nil
 }
 
-// Generate the entry point for the code generation module
+// Options are the code generation options given by library callers,
+// they take precedence over the parameters of the CodeGeneratorRequest
+type Options struct {
+	// Lang is the output plugin, ts by default
+	Lang string
+	// JSONNaming is the JSON naming policy of the message fields, JSONNamingOriginal by default
+	JSONNaming string
+	// TemplateDir is a directory of templates overriding the embedded ones
+	TemplateDir string
+	// Params holds the other parameters passed to the output plugins
+	Params map[string]string
+}
+
+// Error is the error returned when code generation fails
+type Error struct {
+	// Lang is the output plugin which failed, empty when the request itself is invalid
+	Lang string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	if e.Lang == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %v", e.Lang, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Generate the entry point for the code generation module when running as a protoc plugin,
+// failures are reported through CodeGeneratorResponse.Error
 func Generate(input []byte) *plugin.CodeGeneratorResponse {
 	request := new(plugin.CodeGeneratorRequest)
 
 	err := proto.Unmarshal(input, request)
 	if err != nil {
-		util.Die(fmt.Errorf("invalid CodeGeneratorRequest: %v", err))
+		return errorResponse(&Error{Err: fmt.Errorf("invalid CodeGeneratorRequest: %v", err)})
 	}
 
-	if len(request.FileToGenerate) != 1 {
-		util.Die(fmt.Errorf("Multiple input files given: %v\nprotoapi only support one proto file", request.FileToGenerate))
+	if _, ok := parseParameter(request)[data.LintParam]; ok {
+		return lintResponse(Lint(request))
 	}
 
-	var outputLang = "ts"
+	response, err := GenerateWithOptions(request, nil)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return response
+}
+
+func errorResponse(err error) *plugin.CodeGeneratorResponse {
+	response := new(plugin.CodeGeneratorResponse)
+	setSupportedFeatures(response, featureProto3Optional)
+	response.Error = proto.String(err.Error())
+	return response
+}
+
+// parseParameter parses the comma separated key=value parameters of the request
+func parseParameter(request *plugin.CodeGeneratorRequest) map[string]string {
 	var params = make(map[string]string)
 	parameter := request.GetParameter()
 
 	if parameter != "" {
-		parameters := strings.Split(*(request.Parameter), ",")
+		parameters := strings.Split(parameter, ",")
 		for _, parameter := range parameters {
 			kv := strings.Split(parameter, "=")
 			if len(kv) == 2 {
-				if strings.Compare(kv[0], "lang") == 0 {
-					outputLang = kv[1]
-				}
 				params[kv[0]] = kv[1]
 			} else {
 				params[kv[0]] = ""
 			}
 		}
 	}
+	return params
+}
+
+// GenerateWithOptions generates the code of the request, it never exits the process and
+// returns an *Error when the request is invalid or the output plugin fails
+func GenerateWithOptions(request *plugin.CodeGeneratorRequest, opts *Options) (response *plugin.CodeGeneratorResponse, err error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	params := parseParameter(request)
+	for k, v := range opts.Params {
+		params[k] = v
+	}
+	if opts.Lang != "" {
+		params["lang"] = opts.Lang
+	}
+	if opts.JSONNaming != "" {
+		params[jsonNamingParam] = opts.JSONNaming
+	}
+	if opts.TemplateDir != "" {
+		params[data.TemplateDirParam] = opts.TemplateDir
+	}
+
+	var outputLang = "ts"
+	if lang := params["lang"]; lang != "" {
+		outputLang = lang
+	}
+
+	// output plugins are not supposed to panic, do not let a bug kill the caller
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("%s: %s", r, debug.Stack())
+			response = nil
+			err = &Error{Lang: outputLang, Err: fmt.Errorf("%v", r)}
+		}
+	}()
+
+	if len(request.FileToGenerate) == 0 {
+		return nil, &Error{Err: errors.New("No input file given")}
+	}
+
+	newGen, ok := data.GetOutputPlugin(outputLang)
+	if !ok {
+		return nil, &Error{Err: fmt.Errorf("Output plugin not found for %s\nsupported options: %v", outputLang, reflect.ValueOf(data.OutputMap).MapKeys())}
+	}
 
+	jsonNaming := params[jsonNamingParam]
+	switch jsonNaming {
+	case "":
+		jsonNaming = JSONNamingOriginal
+	case JSONNamingOriginal, JSONNamingCamel:
+	default:
+		return nil, &Error{Err: fmt.Errorf("Invalid %s %q, expected %s or %s", jsonNamingParam, jsonNaming, JSONNamingOriginal, JSONNamingCamel)}
+	}
+
+	if dir := params[data.TemplateDirParam]; dir != "" {
+		if stat, err := os.Stat(dir); err != nil || !stat.IsDir() {
+			return nil, &Error{Err: fmt.Errorf("Template directory %s is not accessible", dir)}
+		}
+	}
+
+	// the first file on the command line names the application
 	applicationFile := filepath.Base(request.FileToGenerate[0])
-	log.Printf("proto file: %s\n", applicationFile)
+	log.Printf("proto files: %v\n", request.FileToGenerate)
 	log.Printf("code generated: %s\n", outputLang)
 
 	applicationName := applicationFile[0 : len(applicationFile)-len(filepath.Ext(applicationFile))]
 
 	packageName := getPackageName(request)
 
-	options := getFileOptions(request)
+	ext := newExtensionDecoder(request.ProtoFile)
+	options, fileExtensions := getFileOptions(request, ext)
 
-	messages, enums := getMessages(request.ProtoFile)
+	messages, enums, err := getMessages(request.ProtoFile, jsonNaming, ext)
+	if err != nil {
+		return nil, &Error{Err: err}
+	}
 	// Fix same message name issue
 	fixMessageName(messages, enums)
 
-	services := getServices(request.ProtoFile)
+	services, err := getServices(request.ProtoFile, request.FileToGenerate, messages, ext)
+	if err != nil {
+		return nil, &Error{Err: err}
+	}
 
-	data.Setup(request)
+	req := data.NewGenerateReq(request)
+	req.Params = params
+	req.FileExtensions = fileExtensions
 
 	// temporary hack to ignore namespace for current package
 	// should have more strict handling later
 	if services != nil {
 		for _, s := range services {
 			for _, m := range s.Methods {
-				msg, file := data.GetMessageProtoAndFile(m.InputType)
+				msg, file := req.GetMessageProtoAndFile(m.InputType)
 				if file.IsFileToGenerate {
 					m.InputType = msg.Proto.GetName()
 				}
 
-				msg, file = data.GetMessageProtoAndFile(m.OutputType)
+				msg, file = req.GetMessageProtoAndFile(m.OutputType)
 				if file.IsFileToGenerate {
 					m.OutputType = msg.Proto.GetName()
 				}
@@ -696,28 +1257,35 @@ func Generate(input []byte) *plugin.CodeGeneratorResponse {
 		}
 	}
 
-	if gen, ok := data.OutputMap[outputLang]; ok {
-		response := new(plugin.CodeGeneratorResponse)
-		gen.Init(request)
-
-		results, err := gen.Gen(applicationName, packageName, services, messages, enums, options)
-		if err != nil {
-			util.Die(err)
-		}
-		for file, content := range results {
-			var resultFile = new(plugin.CodeGeneratorResponse_File)
-			// generate the file to the specified package
-			fileName := file
-			resultFile.Name = &fileName
-			fileContent := content
-			resultFile.Content = &fileContent
-			response.File = append(response.File, resultFile)
+	gen := newGen()
+	if streamingGen, ok := gen.(data.StreamingGenerator); !ok || !streamingGen.SupportsServerStreaming() {
+		for _, s := range services {
+			for _, m := range s.Methods {
+				if m.ServerStreaming {
+					return nil, &Error{Lang: outputLang, Err: fmt.Errorf("%s.%s: server-streaming methods are not supported by %s", s.Name, m.Name, outputLang)}
+				}
+			}
 		}
-		return response
+	}
+	if err := gen.Init(req); err != nil {
+		return nil, &Error{Lang: outputLang, Err: err}
 	}
 
-	err = fmt.Errorf("Output plugin not found for %s\nsupported options: %v", outputLang, reflect.ValueOf(data.OutputMap).MapKeys())
-	util.Die(err)
+	results, err := gen.Gen(applicationName, packageName, services, messages, enums, options)
+	if err != nil {
+		return nil, &Error{Lang: outputLang, Err: err}
+	}
 
-	return nil
+	response = new(plugin.CodeGeneratorResponse)
+	setSupportedFeatures(response, featureProto3Optional)
+	for file, content := range results {
+		var resultFile = new(plugin.CodeGeneratorResponse_File)
+		// generate the file to the specified package
+		fileName := file
+		resultFile.Name = &fileName
+		fileContent := content
+		resultFile.Content = &fileContent
+		response.File = append(response.File, resultFile)
+	}
+	return response, nil
 }
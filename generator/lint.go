@@ -0,0 +1,357 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+
+	"github.com/yoozoo/protoapi/generator/data"
+	"github.com/yoozoo/protoapi/util"
+)
+
+// Problem is a violation of the protoapi conventions found by Lint
+type Problem struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"` // 1-based, 0 if protoc recorded no position
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+	Warning bool   `json:"warning"` // the generated code works as documented despite the problem
+}
+
+func (p *Problem) String() string {
+	message := p.Message
+	if p.Warning {
+		message = "warning: " + message
+	}
+	if p.Line == 0 {
+		return fmt.Sprintf("%s: %s", p.File, message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", p.File, p.Line, p.Column, message)
+}
+
+// lintType is a message or enum definition, with where it is defined
+type lintType struct {
+	name string // full name without the leading dot
+	file string
+	path string // source code path, see commentPath
+}
+
+type linter struct {
+	ext        *extensionDecoder
+	messages   map[string]*descriptor.DescriptorProto                    // all the messages by full name, ie .pkg.Msg
+	msgMap     map[string]*data.MessageData                              // the fields of all the messages the path parameters refer to
+	shortNames map[string][]*lintType                                    // the generated messages and enums by name without package
+	linted     map[string]bool                                           // the files to lint
+	locations  map[string]map[string]*descriptor.SourceCodeInfo_Location // source code locations by file and path
+	problems   []*Problem
+}
+
+// Lint checks the files to generate against the protoapi conventions that protoc does not know about,
+// ie the types named by the options or the field options fitting the field types. The problems are
+// positioned with the source code info of the files and sorted by position.
+func Lint(request *plugin.CodeGeneratorRequest) []*Problem {
+	l := &linter{
+		ext:        newExtensionDecoder(request.ProtoFile),
+		messages:   make(map[string]*descriptor.DescriptorProto),
+		msgMap:     make(map[string]*data.MessageData),
+		shortNames: make(map[string][]*lintType),
+		linted:     make(map[string]bool),
+		locations:  make(map[string]map[string]*descriptor.SourceCodeInfo_Location),
+	}
+	for _, name := range request.FileToGenerate {
+		l.linted[name] = true
+	}
+
+	for _, file := range request.ProtoFile {
+		locations := make(map[string]*descriptor.SourceCodeInfo_Location)
+		for _, location := range file.GetSourceCodeInfo().GetLocation() {
+			var path string
+			for _, p := range location.GetPath() {
+				path = commentPath(path, int(p))
+			}
+			locations[path] = location
+		}
+		l.locations[file.GetName()] = locations
+
+		// the messages of the files skipped by getMessages are not generated, their names do not clash
+		generated := file.GetName() != googleDescriptorProtoName && !util.IsStrInSlice(file.GetName(), googleAPIProtoNames) &&
+			!util.IsStrInSlice(file.GetName(), data.WellKnownTypeFiles)
+		pkg := file.GetPackage()
+		if pkg != "" {
+			pkg = "." + pkg
+		}
+		l.addEnums(file.GetName(), commentPath("", data.EnumCommentPath), pkg, file.GetEnumType(), generated)
+		l.addMessages(file.GetName(), commentPath("", data.MessageCommentPath), pkg, file.GetMessageType(), generated)
+	}
+
+	for _, file := range request.ProtoFile {
+		if l.linted[file.GetName()] {
+			l.lintFile(file)
+		}
+	}
+
+	sort.SliceStable(l.problems, func(i, j int) bool {
+		a, b := l.problems[i], l.problems[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.problems
+}
+
+// lintResponse returns the problems as the single file of the response, one problem per line
+func lintResponse(problems []*Problem) *plugin.CodeGeneratorResponse {
+	var content string
+	for _, problem := range problems {
+		content += problem.String() + "\n"
+	}
+	response := new(plugin.CodeGeneratorResponse)
+	setSupportedFeatures(response, featureProto3Optional)
+	response.File = append(response.File, &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(data.LintFile),
+		Content: proto.String(content),
+	})
+	return response
+}
+
+func (l *linter) addEnums(file string, path string, pkg string, enums []*descriptor.EnumDescriptorProto, generated bool) {
+	for eIndex, enum := range enums {
+		if generated {
+			l.addShortName(enum.GetName(), &lintType{strings.TrimPrefix(pkg+"."+enum.GetName(), "."), file, commentPath(path, eIndex)})
+		}
+	}
+}
+
+func (l *linter) addMessages(file string, path string, pkg string, messages []*descriptor.DescriptorProto, generated bool) {
+	for mIndex, message := range messages {
+		name := pkg + "." + message.GetName()
+		msgPath := commentPath(path, mIndex)
+		l.messages[name] = message
+		l.msgMap[name] = lintMessageData(name, message)
+		// map entries are rendered as native maps, not as messages
+		if generated && !message.GetOptions().GetMapEntry() {
+			l.addShortName(message.GetName(), &lintType{strings.TrimPrefix(name, "."), file, msgPath})
+		}
+		l.addEnums(file, commentPath(msgPath, data.MessageEnumCommentPath), name, message.GetEnumType(), generated)
+		l.addMessages(file, commentPath(msgPath, data.MessageNestedCommentPath), name, message.GetNestedType(), generated)
+	}
+}
+
+// lintMessageData returns the message with the fields getPathParams checks, ie without options nor comments
+func lintMessageData(name string, message *descriptor.DescriptorProto) *data.MessageData {
+	msgData := &data.MessageData{Name: name}
+	fields := message.GetField()
+	for _, field := range fields {
+		msgField := &data.MessageField{
+			Name:     field.GetName(),
+			Number:   field.GetNumber(),
+			Label:    field.GetLabel().String(),
+			Optional: isProto3Optional(field),
+			DataType: getFieldDataType(field),
+		}
+		if field.OneofIndex != nil && !isSyntheticOneof(int(field.GetOneofIndex()), fields) {
+			msgField.Oneof = message.GetOneofDecl()[field.GetOneofIndex()].GetName()
+		}
+		msgData.Fields = append(msgData.Fields, msgField)
+	}
+	return msgData
+}
+
+func (l *linter) addShortName(name string, t *lintType) {
+	l.shortNames[name] = append(l.shortNames[name], t)
+}
+
+// position returns the line and column of the element at the path, or of its closest parent
+// recorded by protoc. Both are 0 if the file has no source code info
+func (l *linter) position(file string, path string) (line int, column int) {
+	for {
+		if location, ok := l.locations[file][path]; ok && len(location.GetSpan()) >= 2 {
+			return int(location.GetSpan()[0]) + 1, int(location.GetSpan()[1]) + 1
+		}
+		i := strings.LastIndexByte(path, ',')
+		if i < 0 {
+			return 0, 0
+		}
+		path = path[:i]
+	}
+}
+
+func (l *linter) report(file string, path string, format string, args ...interface{}) {
+	line, column := l.position(file, path)
+	l.problems = append(l.problems, &Problem{File: file, Line: line, Column: column, Message: fmt.Sprintf(format, args...)})
+}
+
+// warn reports a problem the generated code works around, like gen does with its warnings
+func (l *linter) warn(file string, path string, format string, args ...interface{}) {
+	l.report(file, path, format, args...)
+	l.problems[len(l.problems)-1].Warning = true
+}
+
+// findMessage returns the message named by an option, the name is either a full name,
+// a name in the package of the file or a name without package unique among the generated messages
+func (l *linter) findMessage(pkg string, name string) *descriptor.DescriptorProto {
+	if strings.Contains(name, ".") {
+		return l.messages["."+strings.TrimPrefix(name, ".")]
+	}
+	if message, ok := l.messages["."+pkg+"."+name]; ok && pkg != "" {
+		return message
+	}
+	if message, ok := l.messages["."+name]; ok {
+		return message
+	}
+	if types := l.shortNames[name]; len(types) == 1 {
+		return l.messages["."+types[0].name]
+	}
+	return nil
+}
+
+func (l *linter) lintFile(file *descriptor.FileDescriptorProto) {
+	pkg := file.GetPackage()
+	l.lintEnums(file, commentPath("", data.EnumCommentPath), pkg, file.GetEnumType())
+	l.lintMessages(file, commentPath("", data.MessageCommentPath), pkg, file.GetMessageType())
+	for sIndex, service := range file.GetService() {
+		l.lintService(file, commentPath("", data.ServiceCommentPath, sIndex), pkg, service)
+	}
+}
+
+// lintShortName reports the messages and enums named like another one of a different package or message,
+// fixMessageName renames the enums and the languages without packages can not tell the messages apart.
+// Each clash is reported once, at the type defined after the first one of the name.
+func (l *linter) lintShortName(file string, path string, fullName string) {
+	name := fullName[strings.LastIndexByte(fullName, '.')+1:]
+	for _, other := range l.shortNames[name] {
+		if other.file == file && other.path == path {
+			continue
+		}
+		// the other type reports the clash if it is linted too and defined after this one
+		if l.linted[other.file] && l.before(file, path, other.file, other.path) {
+			continue
+		}
+		line, column := l.position(other.file, other.path)
+		l.report(file, path, "%s has the same name as %s (%s:%d:%d), they clash in the generated code", fullName, other.name, other.file, line, column)
+		return
+	}
+}
+
+// before returns if the element at path of file comes before the other one, in the order of the problems
+func (l *linter) before(file string, path string, otherFile string, otherPath string) bool {
+	if file != otherFile {
+		return file < otherFile
+	}
+	line, column := l.position(file, path)
+	otherLine, otherColumn := l.position(otherFile, otherPath)
+	if line != otherLine {
+		return line < otherLine
+	}
+	return column < otherColumn
+}
+
+func (l *linter) lintEnums(file *descriptor.FileDescriptorProto, path string, pkg string, enums []*descriptor.EnumDescriptorProto) {
+	for eIndex, enum := range enums {
+		l.lintShortName(file.GetName(), commentPath(path, eIndex), strings.TrimPrefix(pkg+"."+enum.GetName(), "."))
+	}
+}
+
+func (l *linter) lintMessages(file *descriptor.FileDescriptorProto, path string, pkg string, messages []*descriptor.DescriptorProto) {
+	for mIndex, message := range messages {
+		if message.GetOptions().GetMapEntry() {
+			continue
+		}
+		name := strings.TrimPrefix(pkg+"."+message.GetName(), ".")
+		msgPath := commentPath(path, mIndex)
+		l.lintShortName(file.GetName(), msgPath, name)
+
+		for fIndex, field := range message.GetField() {
+			valueField := field
+			if entry := getMapEntry(name, message, field); entry != nil {
+				for _, f := range entry.GetField() {
+					if f.GetNumber() == 2 {
+						valueField = f
+					}
+				}
+			}
+			options, _ := l.ext.decode(fieldOptionsType, field.GetOptions())
+			if _, err := getFieldValidation(options, field, valueField); err != nil {
+				l.report(file.GetName(), commentPath(msgPath, data.MessageFieldCommentPath, fIndex), "%s.%s: %v", name, field.GetName(), err)
+			}
+		}
+
+		l.lintEnums(file, commentPath(msgPath, data.MessageEnumCommentPath), name, message.GetEnumType())
+		l.lintMessages(file, commentPath(msgPath, data.MessageNestedCommentPath), name, message.GetNestedType())
+	}
+}
+
+// commonErrorFields are the fields of the common_error message the go services return the bind and validation errors in
+var commonErrorFields = []struct{ name, errors string }{{"bindError", "bind"}, {"validateError", "validation"}}
+
+func (l *linter) lintService(file *descriptor.FileDescriptorProto, path string, pkg string, service *descriptor.ServiceDescriptorProto) {
+	options, _ := l.ext.decode(serviceOptionsType, service.GetOptions())
+	if commonError := options[data.ServiceCommonErrorOption]; commonError != "" {
+		if message := l.findMessage(pkg, commonError); message == nil {
+			l.report(file.GetName(), path, "%s: %s %q is not a message", service.GetName(), data.ServiceCommonErrorOption, commonError)
+		} else {
+			for _, f := range commonErrorFields {
+				var found bool
+				for _, field := range message.GetField() {
+					found = found || field.GetName() == f.name
+				}
+				if !found {
+					l.report(file.GetName(), path, "%s: %s %s has no %s field, the go services can not return the %s errors", service.GetName(), data.ServiceCommonErrorOption, commonError, f.name, f.errors)
+				}
+			}
+		}
+	}
+
+	basePath := ""
+	if trimmed := strings.Trim(options[data.ServiceBasePathOption], "/"); trimmed != "" {
+		basePath = "/" + trimmed
+	}
+	for mIndex, mtd := range service.GetMethod() {
+		mtdPath := commentPath(path, data.ServiceMethodCommentPath, mIndex)
+		mtdName := service.GetName() + "." + mtd.GetName()
+		options, extensions := l.ext.decode(methodOptionsType, mtd.GetOptions())
+		if errorType := options[data.ErrorTypeMethodOption]; errorType != "" && l.findMessage(pkg, errorType) == nil {
+			l.report(file.GetName(), mtdPath, "%s: %s %q is not a message", mtdName, data.ErrorTypeMethodOption, errorType)
+		}
+
+		warn := func(format string, args ...interface{}) {
+			l.warn(file.GetName(), mtdPath, "%s: %s", mtdName, fmt.Sprintf(format, args...))
+		}
+		bindings, err := getHTTPBindings(mtdName, basePath, options, extensions, warn)
+		if err != nil {
+			l.report(file.GetName(), mtdPath, "%s: %v", mtdName, err)
+			continue
+		}
+		for _, binding := range bindings {
+			if _, err := getPathParams(binding.Path, l.msgMap[mtd.GetInputType()], l.msgMap); err != nil {
+				l.report(file.GetName(), mtdPath, "%s: %v", mtdName, err)
+			}
+		}
+		input := l.messages[mtd.GetInputType()]
+		for _, binding := range bindings {
+			if data.HasHTTPBody(binding.HttpMtd) || input == nil {
+				continue
+			}
+			for _, field := range input.GetField() {
+				typeName := strings.TrimPrefix(field.GetTypeName(), ".")
+				// the map fields are native maps sent in JSON, not message fields
+				if getMapEntry(mtd.GetInputType(), input, field) != nil {
+					continue
+				}
+				if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE && !data.IsWellKnownType(typeName) {
+					l.warn(file.GetName(), mtdPath, "%s: the input of %s requests is sent as the query string, the message field %s is sent in JSON",
+						mtdName, binding.HttpMtd, field.GetName())
+				}
+			}
+			break
+		}
+	}
+}
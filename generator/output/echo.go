@@ -12,7 +12,6 @@ import (
 	"strings"
 	"text/template"
 
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/yoozoo/protoapi/generator/data"
 	"github.com/yoozoo/protoapi/util"
 )
@@ -28,31 +27,33 @@ var (
 type echoGen struct {
 	ApplicationName string
 	PackageName     string
+	req             *data.GenerateReq
+	packages        *goPackages
 	structTpl       *template.Template
 	serviceTpl      *template.Template
 	enumTpl         *template.Template
+
+	// common error types of the generated services, set by the go target
+	commonErrors []string
+	// deprecated methods set the Deprecation and Sunset headers, see data.DeprecationHeadersParam
+	deprecationHeaders bool
 }
 
-func (g *echoGen) getTpl(path string) *template.Template {
-	var err error
-	tpl := template.New("tpl")
-	tplStr := data.LoadTpl(path)
-	result, err := tpl.Parse(tplStr)
-	if err != nil {
-		util.Die(err)
-	}
-	return result
+func (g *echoGen) getTpl(path string) (*template.Template, error) {
+	return g.req.NewTemplate("tpl", path, nil)
 }
 
-func formatBuffer(buf *bytes.Buffer) string {
+// formatBuffer gofmts the generated code, the lines around a syntax error are
+// quoted in the returned error
+func formatBuffer(buf *bytes.Buffer) (string, error) {
 	output, err := format.Source(buf.Bytes())
 	if err == nil {
-		return string(output)
+		return string(output), nil
 	}
 
 	matches := rgxSyntaxError.FindStringSubmatch(err.Error())
 	if matches == nil {
-		util.Die(errors.New("failed to format template"))
+		return "", errors.New("failed to format template")
 	}
 
 	lineNum, _ := strconv.Atoi(matches[1])
@@ -73,21 +74,86 @@ func formatBuffer(buf *bytes.Buffer) string {
 		errBuf.WriteByte('\n')
 	}
 
-	util.Die(fmt.Errorf("failed to format template\n\n%s", errBuf.Bytes()))
-
-	return ""
+	return "", fmt.Errorf("failed to format template\n\n%s", errBuf.Bytes())
 }
 
 func (g *echoGen) getStructFilename(packageName string, obj *echoStruct) string {
 	return packageName + "/" + obj.ClassName() + ".go"
 }
 
-func (g *echoGen) genStruct(obj *echoStruct) string {
+// goPackages keeps track of the go package every proto file is generated into
+type goPackages struct {
+	req        *data.GenerateReq
+	defaultPkg string
+	files      map[string]string
+	enumFiles  map[string]string
+	enumConsts map[*data.EnumData]map[string]string
+
+	// importPrefix is the import path of the output directory, see data.GoImportPrefixParam
+	importPrefix string
+}
+
+// of returns the go package of the given proto file. Files to generate without
+// go_package option and imported files without go_package option both go to
+// the default package.
+func (p *goPackages) of(file *data.ProtoFile) string {
+	if file.Proto == nil {
+		return p.defaultPkg
+	}
+
+	if file.IsFileToGenerate {
+		if pkg, ok := p.files[file.Proto.GetName()]; ok {
+			return pkg
+		}
+		return p.defaultPkg
+	}
+
+	if pkg := file.Proto.GetOptions().GetGoPackage(); pkg != "" {
+		return pkg
+	}
+	return p.defaultPkg
+}
+
+// typeOf returns the go package of a message or enum type
+func (p *goPackages) typeOf(dataType string) (pkg string, isEnum bool, found bool) {
+	if file, ok := p.enumFiles[dataType]; ok {
+		return p.ofName(file), true, true
+	}
+
+	if msg := p.req.GetMessage(dataType); msg != nil {
+		return p.of(msg.File), false, true
+	}
+
+	if e, file := p.req.GetEnumProtoAndFile(dataType); e != nil {
+		return p.of(file), true, true
+	}
+
+	return "", false, false
+}
+
+// importPath returns the import path of a go package. The packages named by a full import path
+// in go_package are imported as is, the others are generated under the output directory
+func (p *goPackages) importPath(pkg string) string {
+	if p.importPrefix == "" || strings.Contains(pkg, "/") {
+		return pkg
+	}
+	return strings.TrimSuffix(p.importPrefix, "/") + "/" + pkg
+}
+
+// ofName returns the go package of the proto file with the given file name
+func (p *goPackages) ofName(filename string) string {
+	if pkg, ok := p.files[filename]; ok {
+		return pkg
+	}
+	return p.defaultPkg
+}
+
+func (g *echoGen) genStruct(obj *echoStruct) (string, error) {
 	buf := bytes.NewBufferString("")
 
 	err := g.structTpl.Execute(buf, obj)
 	if err != nil {
-		util.Die(err)
+		return "", err
 	}
 
 	return formatBuffer(buf)
@@ -97,25 +163,26 @@ func (g *echoGen) getEnumFilename(packageName string, enum *data.EnumData) strin
 	return packageName + "/" + enum.Name + ".go"
 }
 
-func (g *echoGen) genEnum(enum *data.EnumData) string {
+func (g *echoGen) genEnum(enum *data.EnumData) (string, error) {
 	buf := bytes.NewBufferString("")
 
-	obj := newEchoEnum(enum, g.PackageName)
+	obj := newEchoEnum(enum, g.packages.ofName(enum.File), g.packages.enumConsts[enum])
 	err := g.enumTpl.Execute(buf, obj)
 	if err != nil {
-		util.Die(err)
+		return "", err
 	}
 
 	return formatBuffer(buf)
 }
 
-func (g *echoGen) genService(service *data.ServiceData) string {
+func (g *echoGen) genService(service *data.ServiceData) (string, error) {
 	buf := bytes.NewBufferString("")
 
-	obj := newEchoService(service, g.PackageName)
+	obj := newEchoService(service, g.packages.ofName(service.File))
+	obj.DeprecationHeaders = g.deprecationHeaders
 	err := g.serviceTpl.Execute(buf, obj)
 	if err != nil {
-		util.Die(err)
+		return "", err
 	}
 
 	return formatBuffer(buf)
@@ -129,9 +196,24 @@ func genEchoPackageName(packageName string) string {
 	return strings.Replace(packageName, ".", "_", -1)
 }
 
-func (g *echoGen) Init(request *plugin.CodeGeneratorRequest) {
-	for _, file := range request.ProtoFile {
-		if !util.IsStrInSlice(file.GetName(), request.FileToGenerate) {
+// SupportsServerStreaming implements data.StreamingGenerator, the handlers send the responses with protoapigo.SSEStream
+func (g *echoGen) SupportsServerStreaming() bool {
+	return true
+}
+
+func (g *echoGen) Init(req *data.GenerateReq) (err error) {
+	g.req = req
+	// the parameter may be given without a value to turn it on
+	if value, ok := req.Params[data.DeprecationHeadersParam]; ok && value != "" {
+		if g.deprecationHeaders, err = strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("Invalid %s %q, expected true or false", data.DeprecationHeadersParam, value)
+		}
+	} else {
+		g.deprecationHeaders = ok
+	}
+	g.packages = &goPackages{req: req, files: make(map[string]string), importPrefix: req.Params[data.GoImportPrefixParam]}
+	for _, file := range req.Request.ProtoFile {
+		if !util.IsStrInSlice(file.GetName(), req.Request.FileToGenerate) {
 			continue
 		}
 
@@ -140,58 +222,101 @@ func (g *echoGen) Init(request *plugin.CodeGeneratorRequest) {
 			continue
 		}
 
+		// the first go package found is the default package
 		if g.PackageName == "" {
 			g.PackageName = opts.GetGoPackage()
-		} else if g.PackageName != opts.GetGoPackage() {
-			// Implement code gen for different go packages later
-			util.Die(fmt.Errorf("different go package detected: %s, %s", g.PackageName, opts.GetGoPackage()))
 		}
+		g.packages.files[file.GetName()] = opts.GetGoPackage()
 	}
 
-	g.structTpl = g.getTpl("/generator/template/echo_struct.gogo")
-	g.serviceTpl = g.getTpl("/generator/template/echo_service.gogo")
-	g.enumTpl = g.getTpl("/generator/template/echo_enum.gogo")
+	if g.structTpl, err = g.getTpl("/generator/template/echo_struct.gogo"); err != nil {
+		return
+	}
+	if g.serviceTpl, err = g.getTpl("/generator/template/echo_service.gogo"); err != nil {
+		return
+	}
+	g.enumTpl, err = g.getTpl("/generator/template/echo_enum.gogo")
+	return
 }
 
-func (g *echoGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
+// setupPackages uses the proto package name when no go_package option is given
+// and records where the enums are generated and the names of their constants
+func (g *echoGen) setupPackages(packageName string, messages []*data.MessageData, services []*data.ServiceData, enums []*data.EnumData) error {
 	if g.PackageName == "" {
 		g.PackageName = genEchoPackageName(packageName)
 
 		if g.PackageName == "" {
-			util.Die(fmt.Errorf("No package name given"))
+			return errors.New("No package name given")
 		}
 
 		log.Printf("Use proto package name for go: %v", g.PackageName)
 	}
+	g.packages.defaultPkg = g.PackageName
+
+	g.packages.enumFiles = make(map[string]string)
+	for _, enum := range enums {
+		g.packages.enumFiles[enum.Name] = enum.File
+	}
+	// the enum constants must not clash with the struct and the service types of their package
+	types := make(map[string]bool)
+	for _, msg := range messages {
+		types[g.packages.ofName(msg.File)+"."+msg.Name[strings.LastIndex(msg.Name, ".")+1:]] = true
+	}
+	for _, service := range services {
+		pkg := g.packages.ofName(service.File)
+		types[pkg+"."+service.Name] = true
+		types[pkg+".Register"+service.Name] = true
+		types[pkg+".Register"+service.Name+"WithPrefix"] = true
+	}
+	g.packages.enumConsts = goEnumConsts(enums, types, func(enum *data.EnumData) string {
+		return g.packages.ofName(enum.File)
+	})
+	return nil
+}
+
+func (g *echoGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
+	if err = g.setupPackages(packageName, messages, services, enums); err != nil {
+		return
+	}
 
 	g.ApplicationName = applicationName
 	result = make(map[string]string)
 
 	for _, msg := range messages {
-		f := data.GetProtoFile(msg.File)
+		f := g.req.GetProtoFile(msg.File)
 		if !f.IsFileToGenerate && f.Proto.GetOptions().GetGoPackage() != "" {
 			continue
 		}
 
-		obj := newEchoStruct(msg, g.PackageName, enums)
+		pkg := g.packages.of(f)
+		obj := newEchoStruct(msg, pkg, g.packages, enums, g.commonErrors)
 
-		filename := g.getStructFilename(g.PackageName, obj)
-		content := g.genStruct(obj)
+		filename := g.getStructFilename(pkg, obj)
+		content, err := g.genStruct(obj)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", msg.Name, err)
+		}
 
 		result[filename] = content
 	}
 
 	for _, enum := range enums {
-		filename := g.getEnumFilename(g.PackageName, enum)
-		content := g.genEnum(enum)
+		filename := g.getEnumFilename(g.packages.ofName(enum.File), enum)
+		content, err := g.genEnum(enum)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", enum.Name, err)
+		}
 
 		result[filename] = content
 	}
 
 	if g.serviceTpl != nil {
 		for _, service := range services {
-			filename := genEchoFileName(g.PackageName, service)
-			content := g.genService(service)
+			filename := genEchoFileName(g.packages.ofName(service.File), service)
+			content, err := g.genService(service)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", service.Name, err)
+			}
 			result[filename] = content
 		}
 	}
@@ -200,5 +325,5 @@ func (g *echoGen) Gen(applicationName string, packageName string, services []*da
 }
 
 func init() {
-	data.OutputMap["echo"] = &echoGen{}
+	data.OutputMap["echo"] = func() data.CodeGenerator { return &echoGen{} }
 }
@@ -4,13 +4,15 @@ import (
 	"strings"
 
 	"github.com/yoozoo/protoapi/generator/data"
+	"github.com/yoozoo/protoapi/util"
 )
 
 type echoEnumField struct {
 	data.EnumField
+	GoName string // name of the go constant
 }
 
-func newEchoEnum(enum *data.EnumData, packageName string) *echoEnum {
+func newEchoEnum(enum *data.EnumData, packageName string, consts map[string]string) *echoEnum {
 	ss := strings.Split(packageName, "/")
 	s := ss[len(ss)-1]
 	o := &echoEnum{
@@ -18,7 +20,7 @@ func newEchoEnum(enum *data.EnumData, packageName string) *echoEnum {
 		s,
 		nil,
 	}
-	o.init()
+	o.init(consts)
 	return o
 }
 
@@ -28,9 +30,47 @@ type echoEnum struct {
 	Fields  []*echoEnumField
 }
 
-func (s *echoEnum) init() {
+func (s *echoEnum) init(consts map[string]string) {
 	s.Fields = make([]*echoEnumField, len(s.EnumData.Fields))
 	for i, f := range s.EnumData.Fields {
-		s.Fields[i] = &echoEnumField{f}
+		s.Fields[i] = &echoEnumField{f, consts[f.Name]}
 	}
 }
+
+// goEnumConsts returns the names of the go constants of the enum values by enum and value name.
+// The constants share the namespace of their go package with the other enums and the types, so when
+// a value of an enum is found in another enum of the package or names a type of the package, all the
+// values of the enum are prefixed with the enum name like protoc-gen-go does, ie Status_UNKNOWN.
+// The values of the other enums keep their name, with an underscore appended to the reserved ones.
+// types holds the names of the message and service types by package, as package.name.
+func goEnumConsts(enums []*data.EnumData, types map[string]bool, packageOf func(enum *data.EnumData) string) map[*data.EnumData]map[string]string {
+	count := make(map[string]int)
+	taken := make(map[string]bool)
+	for name := range types {
+		taken[name] = true
+	}
+	for _, enum := range enums {
+		taken[packageOf(enum)+"."+enum.Name] = true
+		for _, f := range enum.Fields {
+			count[packageOf(enum)+"."+f.Name]++
+		}
+	}
+	result := make(map[*data.EnumData]map[string]string)
+	for _, enum := range enums {
+		var prefixed bool
+		for _, f := range enum.Fields {
+			name := packageOf(enum) + "." + f.Name
+			prefixed = prefixed || count[name] > 1 || taken[name] || taken[packageOf(enum)+"."+util.SafeGoName(f.Name)]
+		}
+		consts := make(map[string]string)
+		for _, f := range enum.Fields {
+			if prefixed {
+				consts[f.Name] = enum.Name + "_" + f.Name
+			} else {
+				consts[f.Name] = util.SafeGoName(f.Name)
+			}
+		}
+		result[enum] = consts
+	}
+	return result
+}
@@ -9,62 +9,115 @@ import (
 type echoMethod struct {
 	*data.Method
 	ServiceName string
+	typeNames   goTypeNames
 }
 
 func (m *echoMethod) Title() string {
 	return strings.Title(m.Name)
 }
 
-func (m *echoMethod) MethodPath() string {
-	return "." + m.Name
+// echoRoute is a route of a method in the echo router
+type echoRoute struct {
+	HttpMtd string
+	Path    string // path parameters are written like :user_id
 }
 
-func (m *echoMethod) Path() string {
-	return "/" + m.ServiceName + "." + m.Name
+// Routes returns the routes of the bindings of the method
+func (m *echoMethod) Routes() []*echoRoute {
+	routes := make([]*echoRoute, len(m.Bindings))
+	for i, binding := range m.Bindings {
+		var path string
+		for _, part := range binding.PathParts() {
+			if part.Param != nil {
+				path += ":" + part.Param.Name
+			} else {
+				path += part.Text
+			}
+		}
+		routes[i] = &echoRoute{binding.HttpMtd, path}
+	}
+	return routes
 }
 
-func (m *echoMethod) ServiceType() string {
-	if servType, ok := m.Options[data.MethodOptions[data.ServiceTypeMethodOption].Name]; ok {
-		return servType
+// PathFields returns the input fields bound from the path parameters of all the bindings
+func (m *echoMethod) PathFields() []*echoField {
+	var fields []*echoField
+	names := make(map[string]bool)
+	for _, binding := range m.Bindings {
+		for _, f := range binding.PathParams {
+			if !names[f.Name] {
+				names[f.Name] = true
+				fields = append(fields, &echoField{f, false, m.typeNames, ""})
+			}
+		}
 	}
-
-	return "POST"
+	return fields
 }
 
 func (m *echoMethod) ErrorType() string {
-	if errType, ok := m.Options[data.MethodOptions[data.ErrorTypeMethodOption].Name]; ok {
+	if errType, ok := m.Options[data.ErrorTypeMethodOption]; ok {
 		return errType
 	}
 
 	return ""
 }
 
-func wrapGoType(dataType string) string {
-	if val, ok := importGoTypes[dataType]; ok {
+// wrap returns the go type of a method parameter, messages are referred by pointer
+func (t goTypeNames) wrap(dataType string) string {
+	// well-known types are native go types, only refer to them by pointer once
+	if goType, ok := goWellKnownTypes[dataType]; ok {
+		if strings.HasPrefix(goType, "*") {
+			return goType
+		}
+		return "*" + goType
+	}
+
+	if val, ok := t[dataType]; ok {
 		dataType = val
 	}
 
-	if _, ok := wrapperTypes[dataType]; !ok {
-		if strings.Contains(dataType, ".") {
-			dataType = "*" + dataType
-		} else {
-			dataType = "*" + strings.Title(dataType)
-		}
+	if goType, ok := goTypes[dataType]; ok {
+		return goType
+	}
+
+	if strings.Contains(dataType, ".") {
+		return "*" + dataType
 	}
+	return "*" + strings.Title(dataType)
+}
 
+// localGoType returns the type name used by the echo target, which only refers to local types
+// and native go types for the well-known types
+func localGoType(dataType string) string {
+	if goType, ok := goWellKnownTypes[dataType]; ok {
+		return strings.TrimPrefix(goType, "*")
+	}
 	return dataType
 }
 
+func (m *echoMethod) LocalInputType() string {
+	return localGoType(m.InputType)
+}
+
+func (m *echoMethod) LocalOutputType() string {
+	return localGoType(m.OutputType)
+}
+
+// IsWellKnownInput returns if the input is a well-known type, which has no Validate method
+func (m *echoMethod) IsWellKnownInput() bool {
+	return data.IsWellKnownType(m.InputType)
+}
+
 func (m *echoMethod) ErrorGoType() string {
-	return wrapGoType(m.ErrorType())
+	return m.typeNames.wrap(m.ErrorType())
 }
 
 func (m *echoMethod) InputGoType() string {
-	return wrapGoType(m.InputType)
+	return m.typeNames.wrap(m.InputType)
 }
 
 func (m *echoMethod) InputGoTypeName() string {
-	stmt := wrapGoType(m.InputType)
+	stmt := m.typeNames.wrap(m.InputType)
 	if strings.HasPrefix(stmt, "*") {
 		return stmt[1:]
 	}
@@ -73,13 +126,16 @@ func (m *echoMethod) InputGoTypeName() string {
 }
 
 func (m *echoMethod) OutputGoType() string {
-	return wrapGoType(m.OutputType)
+	return m.typeNames.wrap(m.OutputType)
 }
 
 type echoService struct {
 	*data.ServiceData
-	Package string
-	Methods []*echoMethod
+	Package   string
+	Methods   []*echoMethod
+	typeNames goTypeNames
+	// the handlers of the deprecated methods set the Deprecation and Sunset headers
+	DeprecationHeaders bool
 }
 
 func newEchoService(msg *data.ServiceData, packageName string) *echoService {
@@ -90,6 +146,8 @@ func newEchoService(msg *data.ServiceData, packageName string) *echoService {
 		msg,
 		s,
 		nil,
+		make(goTypeNames),
+		false,
 	}
 	o.init()
 
@@ -100,6 +158,6 @@ func (s *echoService) init() {
 	s.Methods = make([]*echoMethod, len(s.ServiceData.Methods))
 	for i, f := range s.ServiceData.Methods {
 		mtd := f
-		s.Methods[i] = &echoMethod{mtd, s.Name}
+		s.Methods[i] = &echoMethod{mtd, s.Name, s.typeNames}
 	}
 }
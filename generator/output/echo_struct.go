@@ -1,19 +1,22 @@
 package output
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/yoozoo/protoapi/generator/data"
+	"github.com/yoozoo/protoapi/util"
 )
 
 type echoField struct {
 	*data.MessageField
-	isEnum bool
+	isEnum    bool
+	typeNames goTypeNames
+	// type assertion reading the oneof wrapper of the field, empty if the field is not a oneof member
+	member string
 }
 
-// this is ugly, should rely on proto_structs later
-var importGoTypes map[string]string
-
 func (s *echoField) Title() string {
 	return strings.Title(s.Name)
 }
@@ -21,14 +24,31 @@ func (s *echoField) Title() string {
 func (s *echoField) Type() string {
 	// if not primary type return data type and ignore the . in the data type
 	dataType := s.DataType
-	if val, ok := importGoTypes[dataType]; ok {
-		dataType = val
+	if goType, ok := goTypes[dataType]; ok {
+		dataType = goType
+	} else if goType, ok := goWellKnownTypes[dataType]; ok {
+		// well-known types are native go types
+		dataType = goType
+	} else {
+		if val, ok := s.typeNames[dataType]; ok {
+			dataType = val
+		}
+
+		if !s.isEnum {
+			dataType = "*" + dataType
+		}
 	}
 
-	if _, ok := wrapperTypes[dataType]; !ok && !s.isEnum {
+	// optional fields are referred by pointer to tell absent from zero values
+	if s.Optional && !strings.HasPrefix(dataType, "*") && !strings.HasPrefix(dataType, "[]") {
 		dataType = "*" + dataType
 	}
 
+	// check if the field is a map, the key is always a scalar type
+	if s.IsMap() {
+		return "map[" + goMapKeyType(s.KeyType) + "]" + dataType
+	}
+
 	// check if the field is repeated
 	if s.Label == data.FieldRepeatedLabel {
 		dataType = "[]" + dataType
@@ -37,27 +57,153 @@ func (s *echoField) Type() string {
 	return dataType
 }
 
-func (s *echoField) ValidateRequired() bool {
-	if _, ok := s.Options[data.FieldOptions[data.RequiredFieldOption].Name]; ok {
-		return true
+// JSONTag returns the json struct tag of the field, absent optional fields are omitted
+func (s *echoField) JSONTag() string {
+	if s.IsDuration() {
+		// durations are marshalled by MarshalJSON
+		return "-"
 	}
-	return false
+	if s.Optional {
+		return s.Key + ",omitempty"
+	}
+	return s.Key
+}
+
+// IsDuration returns if the field holds durations, which are strings like "1.5s" in JSON
+func (s *echoField) IsDuration() bool {
+	return s.DataType == data.DurationType
+}
+
+func (s *echoField) ValidateRequired() bool {
+	return s.Validation != nil && s.Validation.Required
 }
 
 func (s *echoField) ValidateFormat() string {
-	if format, ok := s.Options[data.FieldOptions[data.FormatFieldOption].Name]; ok {
-		return format
+	if s.Validation != nil {
+		return s.Validation.Format
+	}
+	return ""
+}
+
+// ref returns the expression reading the field, oneof members are read from the wrapper x
+func (s *echoField) ref() string {
+	if s.member != "" {
+		return "x." + s.Title()
+	}
+	return "r." + s.Title()
+}
+
+// EmptyCheck returns the condition of an empty field, which fails the required validation,
+// a oneof member is only checked when it is the member set
+func (s *echoField) EmptyCheck() string {
+	if s.member != "" {
+		return "x, ok := " + s.member + "; ok && " + s.emptyCheck()
+	}
+	return s.emptyCheck()
+}
+
+func (s *echoField) emptyCheck() string {
+	field := s.ref()
+	dataType := s.Type()
+	switch {
+	case strings.HasPrefix(dataType, "[]"), strings.HasPrefix(dataType, "map["):
+		return "len(" + field + ") == 0"
+	case strings.HasPrefix(dataType, "*"), dataType == "interface{}":
+		return field + " == nil"
+	case dataType == "string":
+		return field + ` == ""`
+	case dataType == "bool":
+		return "!" + field
+	case dataType == "time.Time":
+		return field + ".IsZero()"
+	case dataType == "struct{}":
+		return "false"
+	}
+	// numbers, enums and durations
+	return field + " == 0"
+}
+
+// ValueScope returns the statement opening the block where Value is checked, empty if Value is the field itself
+func (s *echoField) ValueScope() string {
+	if s.member != "" {
+		// the rules of a oneof member are only checked when the member is set
+		if strings.HasPrefix(s.Type(), "*") {
+			return "if x, ok := " + s.member + "; ok && " + s.ref() + " != nil"
+		}
+		return "if x, ok := " + s.member + "; ok"
+	}
+	if s.Label == data.FieldRepeatedLabel {
+		return "for _, v := range r." + s.Title()
+	}
+	if strings.HasPrefix(s.Type(), "*") {
+		return "if v := r." + s.Title() + "; v != nil"
 	}
 	return ""
 }
 
-func newEchoStruct(msg *data.MessageData, packageName string, enums []*data.EnumData) *echoStruct {
+// Value returns the value checked by the value validation rules
+func (s *echoField) Value() string {
+	if s.member != "" {
+		if strings.HasPrefix(s.Type(), "*") {
+			return "*" + s.ref()
+		}
+		return s.ref()
+	}
+	if s.Label == data.FieldRepeatedLabel {
+		return "v"
+	}
+	if strings.HasPrefix(s.Type(), "*") {
+		return "*v"
+	}
+	return s.ref()
+}
+
+// EnumName returns the name of the enum value, empty for the undeclared values
+func (s *echoField) EnumName() string {
+	return strings.TrimPrefix(s.Value(), "*") + ".String()"
+}
+
+// Length returns the length of the value, strings are counted in characters
+func (s *echoField) Length() string {
+	if s.DataType == data.StringFieldType {
+		return "utf8.RuneCountInString(" + s.Value() + ")"
+	}
+	return "len(" + s.Value() + ")"
+}
+
+// QuotedPattern returns the val_pattern regular expression as a go string literal
+func (s *echoField) QuotedPattern() string {
+	return strconv.Quote(s.Validation.Pattern)
+}
+
+// AppendError returns the statement adding a validation error of the field
+func (s *echoField) AppendError(errorType string) string {
+	return fmt.Sprintf("errs = append(errs, &%s{FieldName: %q, ErrorType: %s%s})",
+		s.typeNames.name("FieldError"), s.Key, s.typeNames.prefix("ValidateErrorType"), errorType)
+}
+
+// echoOneof a oneof group rendered as an interface with one wrapper struct per member
+type echoOneof struct {
+	*data.OneofData
+	Fields []*echoField
+}
+
+func (o *echoOneof) Title() string {
+	return strings.Title(o.Name)
+}
+
+func newEchoStruct(msg *data.MessageData, packageName string, packages *goPackages, enums []*data.EnumData, commonErrors []string) *echoStruct {
 	ss := strings.Split(packageName, "/")
 	s := ss[len(ss)-1]
 	o := &echoStruct{
 		msg,
 		s,
 		nil,
+		nil,
+		packageName,
+		packages,
+		commonErrors,
+		make(goTypeNames),
 	}
 	o.init(enums)
 	return o
@@ -65,17 +211,33 @@ func newEchoStruct(msg *data.MessageData, packageName string, enums []*data.Enum
 
 type echoStruct struct {
 	*data.MessageData
-	Package string
-	Fields  []*echoField
+	Package  string
+	Fields   []*echoField // fields not belonging to any oneof group
+	Oneofs   []*echoOneof
+	goPkg    string
+	packages *goPackages
+	// common error types of the services generated in the same run
+	commonErrors []string
+	typeNames    goTypeNames
 }
 
 func (s *echoStruct) init(enums []*data.EnumData) {
-	importGoTypes = make(map[string]string)
-	s.Fields = make([]*echoField, len(s.MessageData.Fields))
-	for i, f := range s.MessageData.Fields {
-		e, _ := data.GetEnumProtoAndFile(f.DataType)
+	oneofs := make(map[string]*echoOneof)
+	for _, o := range s.MessageData.Oneofs {
+		oneof := &echoOneof{o, nil}
+		oneofs[o.Name] = oneof
+		s.Oneofs = append(s.Oneofs, oneof)
+	}
+
+	for _, f := range s.MessageData.Fields {
+		e, _ := s.packages.req.GetEnumProtoAndFile(f.DataType)
 		isEnum := e != nil
-		s.Fields[i] = &echoField{f, isEnum}
+		if oneof, ok := oneofs[f.Oneof]; ok {
+			member := fmt.Sprintf("r.%s.(*%s_%s)", oneof.Title(), s.ClassName(), strings.Title(f.Name))
+			oneof.Fields = append(oneof.Fields, &echoField{f, isEnum, s.typeNames, member})
+		} else {
+			s.Fields = append(s.Fields, &echoField{f, isEnum, s.typeNames, ""})
+		}
 	}
 }
 
@@ -83,7 +245,22 @@ func (s *echoStruct) Imports() (result string) {
 	var imports []string
 
 	for _, f := range s.MessageData.Fields {
-		imports = appendGoImport(imports, f.DataType)
+		imports = appendGoImport(imports, s.typeNames, s.packages, s.goPkg, f.DataType)
+	}
+
+	if s.HasCustomJSON() {
+		imports = append(imports, `"encoding/json"`)
+	}
+
+	if s.HasDuration() {
+		imports = appendImport(imports, `"github.com/yoozoo/protoapi/protoapigo"`)
+	}
+
+	if s.HasValidation() {
+		for _, t := range []string{"ValidateError", "FieldError", "ValidateErrorType"} {
+			imports = appendGoImport(imports, s.typeNames, s.packages, s.goPkg, t)
+		}
+		imports = s.validationImports(imports)
 	}
 
 	return getGoImport(imports)
@@ -99,12 +276,8 @@ func (s *echoStruct) ClassName() string {
 }
 
 func (s *echoStruct) IsCommonErrorStruct() bool {
-	if _goServices == nil {
-		return false
-	}
-
-	for _, serv := range _goServices {
-		if serv.CommonError() == "*"+s.ClassName() {
+	for _, commonError := range s.commonErrors {
+		if commonError[strings.LastIndex(commonError, ".")+1:] == s.ClassName() {
 			return true
 		}
 	}
@@ -112,12 +285,93 @@ func (s *echoStruct) IsCommonErrorStruct() bool {
 	return false
 }
 
-func (s *echoStruct) ValidateRequired() bool {
+// GoType returns the go type name of a proto type referred by the template
+func (s *echoStruct) GoType(dataType string) string {
+	return s.typeNames.name(dataType)
+}
+
+// GoTypePrefix returns the go package prefix for the constants of a proto enum
+func (s *echoStruct) GoTypePrefix(dataType string) string {
+	return s.typeNames.prefix(dataType)
+}
+
+// HasDuration returns if any field of the struct holds durations
+func (s *echoStruct) HasDuration() bool {
 	for _, f := range s.Fields {
-		if f.ValidateRequired() {
+		if f.IsDuration() {
+			return true
+		}
+	}
+	for _, o := range s.Oneofs {
+		for _, f := range o.Fields {
+			if f.IsDuration() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasCustomJSON returns if the struct needs its own JSON marshalling,
+// for oneof groups and fields whose JSON form differs from the go type
+func (s *echoStruct) HasCustomJSON() bool {
+	return len(s.Oneofs) > 0 || s.HasDuration()
+}
+
+// AllFields returns the fields of the struct followed by the members of its oneof groups
+func (s *echoStruct) AllFields() []*echoField {
+	result := append([]*echoField{}, s.Fields...)
+	for _, o := range s.Oneofs {
+		result = append(result, o.Fields...)
+	}
+	return result
+}
+
+// HasValidation returns if any field of the struct has validation rules
+func (s *echoStruct) HasValidation() bool {
+	for _, f := range s.AllFields() {
+		if f.Validation != nil {
 			return true
 		}
 	}
 
 	return false
 }
+
+// PatternFields returns the fields with the val_pattern option
+func (s *echoStruct) PatternFields() []*echoField {
+	var result []*echoField
+	for _, f := range s.AllFields() {
+		if f.Validation != nil && f.Validation.Pattern != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// validationImports adds the packages used by the validation rules
+func (s *echoStruct) validationImports(imports []string) []string {
+	for _, f := range s.AllFields() {
+		if f.Validation == nil {
+			continue
+		}
+		if f.Validation.Format == "email" {
+			imports = appendImport(imports, `"github.com/yoozoo/protoapi/protoapigo"`)
+		}
+		if f.Validation.Pattern != "" {
+			imports = appendImport(imports, `"regexp"`)
+		}
+		if f.DataType == data.StringFieldType && (f.Validation.MinLength != nil || f.Validation.MaxLength != nil) {
+			imports = appendImport(imports, `"unicode/utf8"`)
+		}
+	}
+	return imports
+}
+
+// appendImport adds a package to the imports once
+func appendImport(imports []string, pkg string) []string {
+	if util.IsStrInSlice(pkg, imports) {
+		return imports
+	}
+	return append(imports, pkg)
+}
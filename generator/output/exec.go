@@ -0,0 +1,109 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/yoozoo/protoapi/generator/data"
+)
+
+// execModelVersion is the version of the JSON model passed to external generators,
+// it is increased on incompatible changes
+const execModelVersion = 1
+
+// execModel is the normalized model written as JSON to the stdin of external generators
+type execModel struct {
+	Version         int                 `json:"version"`
+	ApplicationName string              `json:"applicationName"`
+	PackageName     string              `json:"packageName"`
+	FilesToGenerate []string            `json:"filesToGenerate"`
+	Params          map[string]string   `json:"params"`
+	Options         data.OptionMap      `json:"options"`
+	Extensions      data.ExtensionMap   `json:"extensions,omitempty"`
+	Services        []*data.ServiceData `json:"services"`
+	Messages        []*data.MessageData `json:"messages"`
+	Enums           []*data.EnumData    `json:"enums"`
+}
+
+// execResult is the JSON external generators write to stdout,
+// files maps the file names relative to the output directory to their content
+type execResult struct {
+	Files map[string]string `json:"files"`
+	Error string            `json:"error"`
+}
+
+// execGen runs an external generator given by --lang=exec:/path/to/generator
+type execGen struct {
+	path string
+	req  *data.GenerateReq
+}
+
+// SupportsServerStreaming implements data.StreamingGenerator, the external generators get the serverStreaming flag and decide by themselves
+func (g *execGen) SupportsServerStreaming() bool {
+	return true
+}
+
+func (g *execGen) Init(request *data.GenerateReq) error {
+	g.req = request
+	g.path = strings.TrimPrefix(request.Params["lang"], data.ExecLangPrefix)
+	if g.path == "" || g.path == request.Params["lang"] {
+		return errors.New("No generator executable given, use --lang=exec:/path/to/generator")
+	}
+	return nil
+}
+
+func (g *execGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (map[string]string, error) {
+	model := &execModel{
+		Version:         execModelVersion,
+		ApplicationName: applicationName,
+		PackageName:     packageName,
+		FilesToGenerate: g.req.Request.GetFileToGenerate(),
+		Params:          g.req.Params,
+		Options:         options,
+		Extensions:      g.req.FileExtensions,
+		Services:        services,
+		Messages:        messages,
+		Enums:           enums,
+	}
+	input, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBufferString("")
+	cmd := exec.Command(g.path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = output
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("generator failed: %v", err)
+	}
+
+	var result execResult
+	if err = json.Unmarshal(output.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("invalid generator result: %v", err)
+	}
+	if result.Error != "" {
+		return nil, errors.New(result.Error)
+	}
+
+	// the files must stay in the output directory
+	for name := range result.Files {
+		clean := path.Clean(name)
+		if name == "" || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+			return nil, fmt.Errorf("invalid file name %q", name)
+		}
+	}
+
+	return result.Files, nil
+}
+
+func init() {
+	data.OutputMap[data.ExecLang] = func() data.CodeGenerator { return &execGen{} }
+}
@@ -7,13 +7,10 @@ import (
 	"strconv"
 	"strings"
 
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/yoozoo/protoapi/generator/data"
 	"github.com/yoozoo/protoapi/util"
 )
 
-var _goServices []*goService
-
 // Re-use everything in echoGen, only use different template
 type goGen struct {
 	DataTypes []*data.MessageData
@@ -25,46 +22,119 @@ type goService struct {
 	Gen *goGen
 }
 
-// GetGoPackageAndType convert proto data type like protoapi.common.error to common.Error
-// and return its package name in go
-func getGoPackageAndType(dataType string) (isFileToGenerate bool, pkg, refType string) {
-	_, p := data.GetMessageProtoAndFile(dataType)
-	isFileToGenerate = p.IsFileToGenerate
+// goTypes is the map of proto scalar types and the go types they are mapped to,
+// bytes are base64 strings in JSON which encoding/json does for []byte
+var goTypes = map[string]string{
+	data.DoubleFieldType:   "float64",
+	data.FloatFieldType:    "float32",
+	data.Int32FieldType:    "int32",
+	data.Int64FieldType:    "int64",
+	data.UInt32FieldType:   "uint32",
+	data.UInt64FieldType:   "uint64",
+	data.SInt32FieldType:   "int32",
+	data.SInt64FieldType:   "int64",
+	data.Fixed32FieldType:  "uint32",
+	data.Fixed64FieldType:  "uint64",
+	data.SFixed32FieldType: "int32",
+	data.SFixed64FieldType: "int64",
+	data.BooleanFieldType:  "bool",
+	data.StringFieldType:   "string",
+	data.BytesFieldType:    "[]byte",
+}
 
-	pkg = p.Proto.GetOptions().GetGoPackage()
+// goMapKeyType returns the go type of a map key. encoding/json can not marshal bool keys,
+// they are strings as in proto3 JSON, ie "true" and "false"
+func goMapKeyType(keyType string) string {
+	if keyType == data.BooleanFieldType {
+		return "string"
+	}
+	return goTypes[keyType]
+}
+
+// goTypeNames maps the proto types referred by one generated file to their go type names,
+// it is filled by appendGoImport when the imports of the file are rendered
+type goTypeNames map[string]string
+
+// goWellKnownTypes is the map of well-known types and the go types they are mapped to
+var goWellKnownTypes = map[string]string{
+	data.TimestampType:   "time.Time",
+	data.DurationType:    "time.Duration",
+	data.EmptyType:       "struct{}",
+	data.StructType:      "map[string]interface{}",
+	data.ValueType:       "interface{}",
+	data.ListValueType:   "[]interface{}",
+	data.DoubleValueType: "*float64",
+	data.FloatValueType:  "*float32",
+	data.Int64ValueType:  "*int64",
+	data.UInt64ValueType: "*uint64",
+	data.Int32ValueType:  "*int32",
+	data.UInt32ValueType: "*uint32",
+	data.BoolValueType:   "*bool",
+	data.StringValueType: "*string",
+	data.BytesValueType:  "[]byte",
+}
+
+// GetGoPackageAndType convert proto data type like protoapi.common.error to common.Error
+// and return its package name in go. Types living in the current package are local.
+func getGoPackageAndType(packages *goPackages, currentPkg string, dataType string) (found, isLocal bool, pkg, refType string) {
+	pkg, isEnum, found := packages.typeOf(dataType)
+	if !found {
+		return
+	}
+	isLocal = pkg == currentPkg
 
 	structName := dataType[strings.LastIndex(dataType, ".")+1:]
+	if !isEnum {
+		structName = strings.Title(structName)
+	}
 	pkgName := pkg[strings.LastIndex(pkg, "/")+1:]
 
-	if isFileToGenerate {
-		refType = strings.Title(structName)
+	if isLocal || pkgName == "" {
+		refType = structName
 	} else {
-		if pkgName == "" {
-			refType = strings.Title(structName)
-		} else {
-			refType = pkgName + "." + strings.Title(structName)
-		}
+		refType = pkgName + "." + structName
 	}
 
 	return
 }
 
-func appendGoImport(imports []string, dataType string) []string {
-	if !strings.Contains(dataType, ".") {
+func appendGoImport(imports []string, types goTypeNames, packages *goPackages, currentPkg string, dataType string) []string {
+	if goType, ok := goWellKnownTypes[dataType]; ok {
+		if strings.HasPrefix(goType, "time.") && !util.IsStrInSlice(`"time"`, imports) {
+			imports = append(imports, `"time"`)
+		}
+		types[dataType] = goType
 		return imports
 	}
 
-	isFileToGenerate, pkg, refType := getGoPackageAndType(dataType)
+	found, isLocal, pkg, refType := getGoPackageAndType(packages, currentPkg, dataType)
+	if !found {
+		return imports
+	}
 
-	if !isFileToGenerate && !util.IsStrInSlice(`"`+pkg+`"`, imports) && pkg != "" {
-		imports = append(imports, `"`+pkg+`"`)
+	if importPath := `"` + packages.importPath(pkg) + `"`; !isLocal && !util.IsStrInSlice(importPath, imports) && pkg != "" {
+		imports = append(imports, importPath)
 	}
 
-	importGoTypes[dataType] = refType
+	types[dataType] = refType
 
 	return imports
 }
 
+// name returns the go type name of a proto type registered by appendGoImport
+func (t goTypeNames) name(dataType string) string {
+	if val, ok := t[dataType]; ok {
+		return val
+	}
+	return dataType
+}
+
+// prefix returns the go package prefix for the constants of a proto enum
+func (t goTypeNames) prefix(dataType string) string {
+	refType := t.name(dataType)
+	return refType[:strings.LastIndex(refType, ".")+1]
+}
+
 func getGoImport(imports []string) (result string) {
 	if len(imports) == 0 {
 		return ""
@@ -84,31 +154,42 @@ func getGoImport(imports []string) (result string) {
 
 func (g *goService) Imports() (result string) {
 	var imports []string
+	packages := g.Gen.packages
+	currentPkg := packages.ofName(g.File)
 
 	for _, m := range g.Methods {
-		imports = appendGoImport(imports, m.InputType)
-		imports = appendGoImport(imports, m.OutputType)
-		imports = appendGoImport(imports, m.ErrorType())
+		imports = appendGoImport(imports, g.typeNames, packages, currentPkg, m.InputType)
+		imports = appendGoImport(imports, g.typeNames, packages, currentPkg, m.OutputType)
+		imports = appendGoImport(imports, g.typeNames, packages, currentPkg, m.ErrorType())
 	}
 
 	if g.HasCommonError() {
-		imports = appendGoImport(imports, g.commonError())
+		imports = appendGoImport(imports, g.typeNames, packages, currentPkg, g.commonError())
+	}
+
+	if g.HasCommonBindError() {
+		imports = appendGoImport(imports, g.typeNames, packages, currentPkg, "BindError")
 	}
 
 	return getGoImport(imports)
 }
 
+// GoType returns the go type name of a proto type referred by the template
+func (g *goService) GoType(dataType string) string {
+	return g.typeNames.name(dataType)
+}
+
 func (g *goService) commonError() string {
-	return g.ServiceData.Options["common_error"]
+	return qualifyType(g.Gen.req, g.File, g.ServiceData.Options["common_error"])
 }
 
 // CommonError returns common error in go type
 func (g *goService) CommonError() string {
-	return wrapGoType(g.commonError())
+	return g.typeNames.wrap(g.commonError())
 }
 
 func (g *goService) CommonErrorPointer() string {
-	return "&" + wrapGoType(g.commonError())[1:]
+	return "&" + g.typeNames.wrap(g.commonError())[1:]
 }
 
 func (g *goService) HasCommonError() bool {
@@ -120,8 +201,10 @@ func (g *goService) hasCommonError(field string) bool {
 		return false
 	}
 
+	// the message names are qualified with their packages, as the common error
+	commonError := g.commonError()
 	for _, t := range g.Gen.DataTypes {
-		if t.Name == g.ServiceData.CommonErrorType {
+		if t.Name == commonError {
 			for _, f := range t.Fields {
 				if f.Name == field {
 					return true
@@ -154,46 +237,112 @@ func (g *goService) AuthRequired() bool {
 	return false
 }
 
-func (g *goService) ServicePath() string {
-	return "/" + g.Name
+// protoMethodTypes returns the full proto names of the method input and output types,
+// which have been shortened for the files to generate
+func protoMethodTypes(req *data.GenerateReq, service *data.ServiceData, method string) (inputType, outputType string, ok bool) {
+	svr, found := req.GetProtoFile(service.File).Services[service.Name]
+	if !found {
+		return
+	}
+
+	for _, m := range svr.Proto.GetMethod() {
+		if m.GetName() == method {
+			return strings.TrimPrefix(m.GetInputType(), "."), strings.TrimPrefix(m.GetOutputType(), "."), true
+		}
+	}
+
+	return
 }
 
-func (g *goGen) genGoService(service *data.ServiceData) string {
-	importGoTypes = make(map[string]string)
+// qualifyType resolves a type name given in an option relative to the proto package of the file
+func qualifyType(req *data.GenerateReq, file string, dataType string) string {
+	pkg := req.GetProtoFile(file).Proto.GetPackage()
+	if pkg == "" || dataType == "" || strings.Contains(dataType, ".") {
+		return dataType
+	}
 
+	if req.GetMessage(pkg+"."+dataType) != nil {
+		return pkg + "." + dataType
+	}
+
+	return dataType
+}
+
+func (g *goGen) genGoService(service *data.ServiceData) (string, error) {
 	buf := bytes.NewBufferString("")
 
-	obj := newEchoService(service, g.PackageName)
+	obj := newEchoService(service, g.packages.ofName(service.File))
+	obj.DeprecationHeaders = g.deprecationHeaders
+	for _, m := range obj.Methods {
+		// restore the full names so types from other go packages can be referred
+		if inputType, outputType, ok := protoMethodTypes(g.req, service, m.Name); ok {
+			mtd := *m.Method
+			mtd.InputType = inputType
+			mtd.OutputType = outputType
+			mtd.Options = make(data.OptionMap)
+			for k, v := range m.Options {
+				mtd.Options[k] = v
+			}
+			errorOption := data.ErrorTypeMethodOption
+			if errType, ok := mtd.Options[errorOption]; ok {
+				mtd.Options[errorOption] = qualifyType(g.req, service.File, errType)
+			}
+			m.Method = &mtd
+		}
+	}
 
-	_goService := &goService{obj, g}
-	_goServices = append(_goServices, _goService)
-	err := g.serviceTpl.Execute(buf, _goService)
+	goSvr := &goService{obj, g}
+	if commonError := goSvr.commonError(); commonError != "" {
+		g.commonErrors = append(g.commonErrors, commonError)
+	}
+	err := g.serviceTpl.Execute(buf, goSvr)
 	if err != nil {
-		util.Die(err)
+		return "", err
 	}
 
 	return formatBuffer(buf)
 }
 
-func (g *goGen) Init(request *plugin.CodeGeneratorRequest) {
-	g.echoGen.Init(request)
+// SupportsServerStreaming implements data.StreamingGenerator, the handlers send the responses with protoapigo.SSEStream
+func (g *goGen) SupportsServerStreaming() bool {
+	return true
+}
+
+func (g *goGen) Init(request *data.GenerateReq) (err error) {
+	if err = g.echoGen.Init(request); err != nil {
+		return
+	}
 
-	g.structTpl = g.getTpl("/generator/template/go/struct.gogo")
-	g.enumTpl = g.getTpl("/generator/template/go/enum.gogo")
+	if g.structTpl, err = g.getTpl("/generator/template/go/struct.gogo"); err != nil {
+		return
+	}
+	g.enumTpl, err = g.getTpl("/generator/template/go/enum.gogo")
+	return
 }
 
 func (g *goGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
+	if err = g.setupPackages(packageName, messages, services, enums); err != nil {
+		return
+	}
 	g.DataTypes = messages
 	serviceResult := make(map[string]string)
 	for _, service := range services {
-		g.serviceTpl = g.getTpl("/generator/template/go/service.gogo")
-		serviceContent := g.genGoService(service)
-		serviceFilename := genEchoFileName(g.PackageName, service)
+		if g.serviceTpl, err = g.getTpl("/generator/template/go/service.gogo"); err != nil {
+			return
+		}
+		serviceContent, err := g.genGoService(service)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", service.Name, err)
+		}
+		serviceFilename := genEchoFileName(g.packages.ofName(service.File), service)
 		g.serviceTpl = nil
 		serviceResult[serviceFilename] = serviceContent
 	}
 
 	result, err = g.echoGen.Gen(applicationName, packageName, services, messages, enums, options)
+	if err != nil {
+		return
+	}
 	for k, v := range serviceResult {
 		result[k] = v
 	}
@@ -202,5 +351,5 @@ func (g *goGen) Gen(applicationName string, packageName string, services []*data
 }
 
 func init() {
-	data.OutputMap["go"] = &goGen{}
+	data.OutputMap["go"] = func() data.CodeGenerator { return &goGen{} }
 }
@@ -3,42 +3,46 @@ package output
 import (
 	"bytes"
 	"errors"
-	"fmt"
 	"go/format"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
 
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/yoozoo/protoapi/generator/data"
-	"github.com/yoozoo/protoapi/generator/data/tpl"
-	"github.com/yoozoo/protoapi/util"
 )
 
 // create template data struct
 type goStruct struct {
 	Package  string
-	Name     string
 	Messages []*data.MessageData
-	Methods  []*data.Method
+	Services []*data.ServiceData
 	Enums    []*data.EnumData
 	Time     string
 	ComErr   *data.MessageData
+	HasTime  bool
+	// HasPathParams and HasQuery tell if the helpers building the urls are needed
+	HasPathParams bool
+	HasQuery      bool
+	// HasStreaming tells if the helpers reading the Server-Sent Events are needed
+	HasStreaming bool
 }
 
-type goClientGen struct{}
+type goClientGen struct {
+	req *data.GenerateReq
+}
 
-func (g *goClientGen) Init(request *plugin.CodeGeneratorRequest) {
+// SupportsServerStreaming implements data.StreamingGenerator, the clients return channels of the responses
+func (g *goClientGen) SupportsServerStreaming() bool {
+	return true
 }
 
-func (g *goClientGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
-	var service *data.ServiceData
-	if len(services) > 1 {
-		util.Die(fmt.Errorf("goclient found %d services; only 1 service is supported now", len(services)))
-	} else if len(services) == 1 {
-		service = services[0]
-	}
+func (g *goClientGen) Init(request *data.GenerateReq) error {
+	g.req = request
+	return nil
+}
 
+func (g *goClientGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
 	//获取可能的package name
 	if len(packageName) == 0 {
 		packageName = "yoozooagent"
@@ -49,17 +53,21 @@ func (g *goClientGen) Gen(applicationName string, packageName string, services [
 	if len(fileName) > 0 {
 		fileName += "/"
 	}
-	fileName += service.Name + ".go"
-
-	//读取template文件
-	goTemplate := tpl.FSMustString(false, "/generator/template/go_client.gogo")
+	// all the clients share the models and go to the file named after the first service
+	if len(services) > 0 {
+		fileName += services[0].Name + ".go"
+	} else {
+		fileName += applicationName + ".go"
+	}
 
 	// create template function map
 	bizErrorMsgs := make(map[string]bool)
-	for _, serv := range service.Methods {
-		errorMsgName, found := serv.Options["error"]
-		if found {
-			bizErrorMsgs[errorMsgName] = true
+	for _, service := range services {
+		for _, serv := range service.Methods {
+			errorMsgName, found := serv.Options["error"]
+			if found {
+				bizErrorMsgs[errorMsgName] = true
+			}
 		}
 	}
 	isBizErr := func(name string) bool {
@@ -82,42 +90,77 @@ func (g *goClientGen) Gen(applicationName string, packageName string, services [
 	if comError == nil {
 		return nil, errors.New("Cannot find common error message")
 	}
+	for _, msg := range messages {
+		g.req.FlattenLocalPackage(msg)
+	}
+	// the 420 responses of a service are its common_error, the CommonError message by default
+	comErrOf := func(service *data.ServiceData) string {
+		if commonError, ok := service.Options["common_error"]; ok {
+			return commonError[strings.LastIndex(commonError, ".")+1:]
+		}
+		return comError.Name
+	}
 	isComErr := func(name string) bool {
 		for _, field := range comError.Fields {
 			if field.DataType == name {
 				return true
 			}
 		}
+		for _, service := range services {
+			if comErrOf(service) == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	isEnum := func(fieldType string) bool {
+		for _, enum := range enums {
+			if enum.Name == fieldType {
+				return true
+			}
+		}
 		return false
 	}
 
 	isObject := func(fieldType string) bool {
-		switch fieldType {
-		case data.StringFieldType,
-			data.DoubleFieldType,
-			data.IntFieldType,
-			data.Int32FieldType,
-			data.Int64FieldType,
-			data.BooleanFieldType:
+		// scalar and well-known types are native go types
+		if data.IsScalarType(fieldType) || data.IsWellKnownType(fieldType) {
 			return false
-		default:
-			// check if is enum
-			for _, enum := range enums {
-				if enum.Name == fieldType {
-					return false
-				}
-			}
-			return true
 		}
+		return !isEnum(fieldType)
+	}
+
+	// the client has no custom JSON marshalling, durations are kept in their proto3 JSON form like "1.5s"
+	wellKnownType := func(dataType string) (string, bool) {
+		if dataType == data.DurationType {
+			return "string", true
+		}
+		goType, ok := goWellKnownTypes[dataType]
+		return goType, ok
 	}
 
 	toType := func(s *data.MessageField) string {
 		dataType := s.DataType
 		// if not primary type return data type and ignore the . in the data type
-		if isObject(dataType) {
+		if goType, ok := goTypes[dataType]; ok {
+			dataType = goType
+		} else if goType, ok := wellKnownType(dataType); ok {
+			dataType = goType
+		} else if isObject(dataType) {
+			dataType = "*" + dataType
+		}
+
+		// optional fields are referred by pointer to tell absent from zero values
+		if s.Optional && !strings.HasPrefix(dataType, "*") && !strings.HasPrefix(dataType, "[]") {
 			dataType = "*" + dataType
 		}
 
+		// check if the field is a map, the key is always a scalar type
+		if s.IsMap() {
+			return "map[" + goMapKeyType(s.KeyType) + "]" + dataType
+		}
+
 		// check if the field is repeated
 		if s.Label == data.FieldRepeatedLabel {
 			dataType = "[]" + dataType
@@ -126,27 +169,105 @@ func (g *goClientGen) Gen(applicationName string, packageName string, services [
 		return dataType
 	}
 
+	// method types are referred by pointer, well-known types are native go types
+	toTypeName := func(dataType string) string {
+		if goType, ok := wellKnownType(dataType); ok {
+			return strings.TrimPrefix(goType, "*")
+		}
+		return dataType
+	}
+
+	hasTime := false
+	for _, f := range comError.Fields {
+		hasTime = hasTime || f.DataType == data.TimestampType
+	}
+	for _, msg := range messages {
+		for _, f := range msg.Fields {
+			hasTime = hasTime || f.DataType == data.TimestampType
+		}
+	}
+
+	// the url is relative to the api url of the client
+	toURI := func(m *data.Method) string {
+		var parts []string
+		for i, part := range m.PathParts() {
+			if part.Param != nil {
+				param := "reqData." + strings.Title(part.Param.Name)
+				// the server parses the enums of the path by number
+				if isEnum(part.Param.DataType) {
+					param += ".Code()"
+				}
+				parts = append(parts, "pathParam("+param+")")
+				continue
+			}
+			text := part.Text
+			if i == 0 {
+				text = strings.TrimPrefix(text, "/")
+			}
+			if text != "" {
+				parts = append(parts, strconv.Quote(text))
+			}
+		}
+		if len(parts) == 0 {
+			return `""`
+		}
+		return strings.Join(parts, " + ")
+	}
+
+	var hasPathParams, hasQuery, hasStreaming bool
+	for _, service := range services {
+		for _, m := range service.Methods {
+			hasPathParams = hasPathParams || len(m.PathParams) > 0
+			hasQuery = hasQuery || !m.HasBody()
+			hasStreaming = hasStreaming || m.ServerStreaming
+		}
+	}
+
+	// all the enums share the package of the client with the structs and the services
+	types := make(map[string]bool)
+	for _, msg := range messages {
+		types[nameSpace+"."+strings.Title(msg.Name)] = true
+	}
+	types[nameSpace+"."+comError.Name] = true
+	for _, service := range services {
+		types[nameSpace+"."+strings.Title(service.Name)] = true
+	}
+	enumConsts := goEnumConsts(enums, types, func(*data.EnumData) string {
+		return nameSpace
+	})
+	toConst := func(enum *data.EnumData, value string) string {
+		return enumConsts[enum][value]
+	}
+
 	funcMap := template.FuncMap{
+		"comErrOf": comErrOf,
+		"goConst":  toConst,
+		"goURI":    toURI,
 		"isObject": isObject,
 		"isBizErr": isBizErr,
 		"isComErr": isComErr,
 		"title":    strings.Title,
 		"type":     toType,
+		"typeName": toTypeName,
 	}
 
 	// fill in data
 	templateData := goStruct{
 		Package:  nameSpace,
 		Messages: messages,
-		Name:     service.Name,
-		Methods:  service.Methods,
+		Services: services,
 		Enums:    enums,
 		Time:     time.Now().Format(time.RFC822),
 		ComErr:   comError,
+		HasTime:  hasTime,
+
+		HasPathParams: hasPathParams,
+		HasQuery:      hasQuery,
+		HasStreaming:  hasStreaming,
 	}
 
 	//create a template
-	tmpl, err := template.New("go client template").Funcs(funcMap).Parse(string(goTemplate))
+	tmpl, err := g.req.NewTemplate("go client template", "/generator/template/go_client.gogo", funcMap)
 	if err != nil {
 		return nil, err
 	}
@@ -168,5 +289,5 @@ func (g *goClientGen) Gen(applicationName string, packageName string, services [
 }
 
 func init() {
-	data.OutputMap["goclient"] = &goClientGen{}
+	data.OutputMap["goclient"] = func() data.CodeGenerator { return &goClientGen{} }
 }
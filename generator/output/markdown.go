@@ -3,15 +3,11 @@ package output
 import (
 	"bytes"
 	"encoding/json"
-	"fmt"
 	"strings"
 	"text/template"
 	"time"
 
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/yoozoo/protoapi/generator/data"
-	"github.com/yoozoo/protoapi/generator/data/tpl"
-	"github.com/yoozoo/protoapi/util"
 )
 
 // create template data struct
@@ -24,19 +20,31 @@ type markdownStruct struct {
 }
 
 //contains logic to plug in values to the template specified
-type markdownGen struct{}
+// wellKnownExamples is the map of well-known types and example values of their JSON form
+var wellKnownExamples = map[string]interface{}{
+	data.TimestampType: "1970-01-01T00:00:00Z",
+	data.DurationType:  "1.5s",
+	data.EmptyType:     map[string]interface{}{},
+	data.StructType:    map[string]interface{}{},
+	data.ValueType:     nil,
+	data.ListValueType: []interface{}{},
+}
 
-func (g *markdownGen) Init(request *plugin.CodeGeneratorRequest) {
+type markdownGen struct {
+	req *data.GenerateReq
 }
 
-func (g *markdownGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
-	var service *data.ServiceData
-	if len(services) > 1 {
-		util.Die(fmt.Errorf("found %d services; only 1 service is supported now", len(services)))
-	} else if len(services) == 1 {
-		service = services[0]
-	}
+// SupportsServerStreaming implements data.StreamingGenerator, the docs mark the methods answering with Server-Sent Events
+func (g *markdownGen) SupportsServerStreaming() bool {
+	return true
+}
 
+func (g *markdownGen) Init(request *data.GenerateReq) error {
+	g.req = request
+	return nil
+}
+
+func (g *markdownGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
 	//获取可能的package name
 	if len(packageName) == 0 {
 		packageName = "markdown"
@@ -44,6 +52,8 @@ func (g *markdownGen) Gen(applicationName string, packageName string, services [
 
 	msgMap := make(map[string]*data.MessageData)
 	for _, message := range messages {
+		// the method types of the local package are referred without the package
+		g.req.FlattenLocalPackage(message)
 		msgMap[message.Name] = message
 	}
 
@@ -52,14 +62,10 @@ func (g *markdownGen) Gen(applicationName string, packageName string, services [
 		enumMap[enum.Name] = enum
 	}
 
-	fileName := strings.Replace(packageName, "\\", "/", -1)
-	if len(fileName) > 0 {
-		fileName += "/"
+	filePath := strings.Replace(packageName, "\\", "/", -1)
+	if len(filePath) > 0 {
+		filePath += "/"
 	}
-	fileName += service.Name + ".md"
-
-	//读取template文件
-	markdownTemplate := tpl.FSMustString(false, "/generator/template/markdown.gomd")
 
 	// check if a field is of type enum
 	isEnum := func(fieldType string) bool {
@@ -82,9 +88,17 @@ func (g *markdownGen) Gen(applicationName string, packageName string, services [
 	getDefVal := func(fieldType string) string {
 		switch fieldType {
 		case data.DoubleFieldType,
-			data.IntFieldType,
+			data.FloatFieldType,
 			data.Int32FieldType,
-			data.Int64FieldType:
+			data.Int64FieldType,
+			data.UInt32FieldType,
+			data.UInt64FieldType,
+			data.SInt32FieldType,
+			data.SInt64FieldType,
+			data.Fixed32FieldType,
+			data.Fixed64FieldType,
+			data.SFixed32FieldType,
+			data.SFixed64FieldType:
 			return "0"
 		case data.StringFieldType:
 			return "Success"
@@ -104,18 +118,13 @@ func (g *markdownGen) Gen(applicationName string, packageName string, services [
 		return false
 	}
 
-	// return false for primitive data type and enum
+	// return false for primitive data type, well-known type and enum
 	isMessage := func(fieldType string) bool {
-		switch fieldType {
-		case data.StringFieldType,
-			data.DoubleFieldType,
-			data.IntFieldType,
-			data.BooleanFieldType:
+		if data.IsScalarType(fieldType) || data.IsWellKnownType(fieldType) {
 			return false
-		default:
-			// check if it is enum
-			return !isEnum(fieldType)
 		}
+		// check if it is enum
+		return !isEnum(fieldType)
 	}
 
 	// get the messageData that matches the messageName and return the fields
@@ -136,33 +145,57 @@ func (g *markdownGen) Gen(applicationName string, packageName string, services [
 
 	// filter the messages that is used in the field,
 	// return array of message data including the nested messages structure
-	var getMessagesOfType func(messageName string, rootName string) []*data.MessageData
-	getMessagesOfType = func(messageName string, rootName string) []*data.MessageData {
+	var collectMessages func(messageName string, listed map[string]bool) []*data.MessageData
+	collectMessages = func(messageName string, listed map[string]bool) []*data.MessageData {
 		var filteredMess []*data.MessageData
+		// well-known types are not documented as messages, recursive messages are listed once
+		if data.IsWellKnownType(messageName) || listed[messageName] {
+			return filteredMess
+		}
+		listed[messageName] = true
 		mData := getMessage(messageName)
 
 		filteredMess = append(filteredMess, mData)
 
 		for _, field := range mData.Fields {
 			if isMessage(field.DataType) {
-				filteredMess = append(filteredMess, getMessagesOfType(field.DataType, rootName)...)
+				filteredMess = append(filteredMess, collectMessages(field.DataType, listed)...)
 			}
 		}
 		return filteredMess
 	}
+	getMessagesOfType := func(messageName string, rootName string) []*data.MessageData {
+		return collectMessages(messageName, make(map[string]bool))
+	}
 
-	// make a map of string and interface and map message fields to it to be converted into json
-	var makeJSONMap func(fields []*data.MessageField) map[string]interface{}
-	makeJSONMap = func(fields []*data.MessageField) map[string]interface{} {
+	// make a map of string and interface and map message fields to it to be converted into json,
+	// the messages being expanded are shown as null where they refer back to themselves
+	var makeJSONMap func(fields []*data.MessageField, expanding map[string]bool) map[string]interface{}
+	makeJSONMap = func(fields []*data.MessageField, expanding map[string]bool) map[string]interface{} {
 		jsonData := make(map[string]interface{})
 		for _, field := range fields {
+			var value interface{}
 			if isMessage(field.DataType) {
-				jsonData[field.Name] = makeJSONMap(getFields(field.DataType))
+				if !expanding[field.DataType] {
+					expanding[field.DataType] = true
+					value = makeJSONMap(getFields(field.DataType), expanding)
+					delete(expanding, field.DataType)
+				}
+			} else if example, ok := wellKnownExamples[field.DataType]; ok {
+				value = example
+			} else if _, ok := data.WrapperTypes[field.DataType]; ok {
+				value = nil
 			} else if field.DataType == data.BooleanFieldType {
-				jsonData[field.Name] = false
+				value = false
 			} else {
-				jsonData[field.Name] = getDefVal(field.DataType)
+				value = getDefVal(field.DataType)
+			}
+
+			// show map field as an object with a single key
+			if field.IsMap() {
+				value = map[string]interface{}{"key": value}
 			}
+			jsonData[field.Key] = value
 		}
 		return jsonData
 	}
@@ -170,7 +203,7 @@ func (g *markdownGen) Gen(applicationName string, packageName string, services [
 	// convert the fields to map of string and interface and use MarshalIndent to generate Json
 	// return the string of the json
 	makeJSON := func(fields []*data.MessageField) string {
-		json, _ := json.MarshalIndent(makeJSONMap(fields), "", "   ")
+		json, _ := json.MarshalIndent(makeJSONMap(fields, make(map[string]bool)), "", "   ")
 		return string(json)
 	}
 
@@ -182,36 +215,64 @@ func (g *markdownGen) Gen(applicationName string, packageName string, services [
 		"getMessagesOfType": getMessagesOfType,
 		"makeJSON":          makeJSON,
 		"toUpper":           strings.ToUpper,
-	}
-
-	// fill in data
-	templateData := markdownStruct{
-		Services: service,
-		Messages: messages,
-		Methods:  service.Methods,
-		Enums:    enums,
-		Time:     time.Now().Format(time.RFC822),
+		"strike":            strike,
+		"inline":            inline,
+		"indent":            indent,
 	}
 
 	//create a template
-	tmpl, err := template.New("markdown template").Funcs(funcMap).Parse(string(markdownTemplate))
-	if err != nil {
-		return nil, err
-	}
-
-	//parse file and generate file content according to the template
-	buf := bytes.NewBufferString("")
-	err = tmpl.Execute(buf, templateData)
+	tmpl, err := g.req.NewTemplate("markdown template", "/generator/template/markdown.gomd", funcMap)
 	if err != nil {
 		return nil, err
 	}
-	fileContent := buf.String()
 
 	result = make(map[string]string)
-	result[fileName] = fileContent
+	// one document per service
+	for _, service := range services {
+		// fill in data
+		templateData := markdownStruct{
+			Services: service,
+			Messages: messages,
+			Methods:  service.Methods,
+			Enums:    enums,
+			Time:     time.Now().Format(time.RFC822),
+		}
+
+		//parse file and generate file content according to the template
+		buf := bytes.NewBufferString("")
+		err = tmpl.Execute(buf, templateData)
+		if err != nil {
+			return nil, err
+		}
+		result[filePath+service.Name+".md"] = buf.String()
+	}
 	return result, nil
 }
 
+// strike strikes through the text of deprecated entries
+func strike(deprecated bool, text string) string {
+	if deprecated {
+		return "~~" + text + "~~"
+	}
+	return text
+}
+
+// inline joins the lines of a comment to fit in a heading or a table cell
+func inline(comment string) string {
+	return strings.Replace(strings.Join(strings.Fields(comment), " "), "|", "\\|", -1)
+}
+
+// indent indents the lines after the first one of a comment, so they continue its list item
+func indent(prefix, comment string) string {
+	lines := strings.Split(comment, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = prefix + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 func init() {
-	data.OutputMap["markdown"] = &markdownGen{}
+	data.OutputMap["markdown"] = func() data.CodeGenerator { return &markdownGen{} }
 }
@@ -3,14 +3,11 @@ package output
 import (
 	"bytes"
 	"errors"
-	"fmt"
 	"strings"
 	"text/template"
 	"time"
 
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/yoozoo/protoapi/generator/data"
-	"github.com/yoozoo/protoapi/generator/data/tpl"
 	"github.com/yoozoo/protoapi/util"
 )
 
@@ -19,25 +16,22 @@ type phpStruct struct {
 	NameSpace string
 	Name      string
 	Messages  []*data.MessageData
-	Methods   []*data.Method
+	Services  []*data.ServiceData
 	Enums     []*data.EnumData
 	Time      string
 	ComErr    *data.MessageData
 }
 
-type phpClientGen struct{}
+type phpClientGen struct {
+	req *data.GenerateReq
+}
 
-func (g *phpClientGen) Init(request *plugin.CodeGeneratorRequest) {
+func (g *phpClientGen) Init(request *data.GenerateReq) error {
+	g.req = request
+	return nil
 }
 
 func (g *phpClientGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
-	var service *data.ServiceData
-	if len(services) > 1 {
-		util.Die(fmt.Errorf("found %d services; only 1 service is supported now", len(services)))
-	} else if len(services) == 1 {
-		service = services[0]
-	}
-
 	//获取可能的package name
 	if len(packageName) == 0 {
 		packageName = "Yoozoo\\Agent"
@@ -48,17 +42,21 @@ func (g *phpClientGen) Gen(applicationName string, packageName string, services
 	if len(fileName) > 0 {
 		fileName += "/"
 	}
-	fileName += service.Name + ".php"
-
-	//读取template文件
-	phpTemplate := tpl.FSMustString(false, "/generator/template/php_client.gophp")
+	// all the clients share the models and go to the file named after the first service
+	if len(services) > 0 {
+		fileName += services[0].Name + ".php"
+	} else {
+		fileName += applicationName + ".php"
+	}
 
 	// create template function map
 	bizErrorMsgs := make(map[string]bool)
-	for _, serv := range service.Methods {
-		errorMsgName, found := serv.Options["error"]
-		if found {
-			bizErrorMsgs[errorMsgName] = true
+	for _, service := range services {
+		for _, serv := range service.Methods {
+			errorMsgName, found := serv.Options["error"]
+			if found {
+				bizErrorMsgs[errorMsgName] = true
+			}
 		}
 	}
 	isBizErr := func(name string) bool {
@@ -80,13 +78,60 @@ func (g *phpClientGen) Gen(applicationName string, packageName string, services
 	}
 
 	for _, msg := range messages {
-		data.FlattenLocalPackage(msg)
+		g.req.FlattenLocalPackage(msg)
+	}
+
+	// methods taking or returning google.protobuf.Empty need a class for it
+	if data.UsesType(services, data.EmptyType) {
+		messages = append(messages, &data.MessageData{Name: data.EmptyType})
 	}
 
 	if comError == nil {
 		return nil, errors.New("Cannot find common error message")
 	}
+	isObject := func(fieldType string) bool {
+		// scalar and well-known types are plain JSON values
+		if data.IsScalarType(fieldType) || data.IsWellKnownType(fieldType) {
+			return false
+		}
+		// check if is enum
+		for _, enum := range enums {
+			if enum.Name == fieldType {
+				return false
+			}
+		}
+		return true
+	}
+
+	// the 420 responses of a service are its common_error, the CommonError message by default,
+	// the message fields are thrown as exceptions
+	comErrFields := func(service *data.ServiceData) []*data.MessageField {
+		commonError, ok := service.Options["common_error"]
+		name := commonError[strings.LastIndex(commonError, ".")+1:]
+		if !ok || name == comError.Name {
+			return comError.Fields
+		}
+		var fields []*data.MessageField
+		for _, msg := range messages {
+			if msg.Name != name {
+				continue
+			}
+			for _, field := range msg.Fields {
+				if isObject(field.DataType) && field.Label != data.FieldRepeatedLabel {
+					fields = append(fields, field)
+				}
+			}
+		}
+		return fields
+	}
 	isComErr := func(name string) bool {
+		for _, service := range services {
+			for _, field := range comErrFields(service) {
+				if field.DataType == name {
+					return true
+				}
+			}
+		}
 		for _, field := range comError.Fields {
 			if field.DataType == name {
 				return true
@@ -95,44 +140,37 @@ func (g *phpClientGen) Gen(applicationName string, packageName string, services
 		return false
 	}
 
-	isObject := func(fieldType string) bool {
-		switch fieldType {
-		case data.StringFieldType,
-			data.DoubleFieldType,
-			data.IntFieldType,
-			data.BooleanFieldType:
-			return false
-		default:
-			// check if is enum
-			for _, enum := range enums {
-				if enum.Name == fieldType {
-					return false
-				}
-			}
-			return true
-		}
+	// wrapper well-known types may be null
+	isNullable := func(fieldType string) bool {
+		_, ok := data.WrapperTypes[fieldType]
+		return ok || fieldType == data.ValueType
 	}
 
 	funcMap := template.FuncMap{
-		"isObject": isObject,
-		"isBizErr": isBizErr,
-		"isComErr": isComErr,
-		"title":    strings.Title,
+		"isObject":     isObject,
+		"isNullable":   isNullable,
+		"isBizErr":     isBizErr,
+		"isComErr":     isComErr,
+		"comErrFields": comErrFields,
+		"title":        strings.Title,
+		"className":    util.GetPHPClassName,
+		"constName":    util.GetPHPConstName,
+		"phpRegex":     util.GetPHPRegex,
+		"phpURI":       phpURI,
 	}
 
 	// fill in data
 	templateData := phpStruct{
 		NameSpace: nameSpace,
 		Messages:  messages,
-		Name:      service.Name,
-		Methods:   service.Methods,
+		Services:  services,
 		Enums:     enums,
 		Time:      time.Now().Format(time.RFC822),
 		ComErr:    comError,
 	}
 
 	//create a template
-	tmpl, err := template.New("php client template").Funcs(funcMap).Parse(string(phpTemplate))
+	tmpl, err := g.req.NewTemplate("php client template", "/generator/template/php_client.gophp", funcMap)
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +188,32 @@ func (g *phpClientGen) Gen(applicationName string, packageName string, services
 	return result, nil
 }
 
+// phpURI returns the PHP expression of the uri of a method, the path parameters are read from $req
+func phpURI(m *data.Method) string {
+	var parts []string
+	for i, part := range m.PathParts() {
+		if part.Param != nil {
+			parts = append(parts, "rawurlencode($req->get_"+part.Param.Name+"())")
+			continue
+		}
+		text := part.Text
+		// the uri is relative to the base uri of the client
+		if i == 0 {
+			text = strings.TrimPrefix(text, "/")
+		}
+		if text != "" {
+			parts = append(parts, `"`+phpStringEscaper.Replace(text)+`"`)
+		}
+	}
+	if len(parts) == 0 {
+		return `""`
+	}
+	return strings.Join(parts, " . ")
+}
+
+// phpStringEscaper escapes the special characters of double quoted PHP strings
+var phpStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`)
+
 func init() {
-	data.OutputMap["phpclient"] = &phpClientGen{}
+	data.OutputMap["phpclient"] = func() data.CodeGenerator { return &phpClientGen{} }
 }
@@ -2,17 +2,15 @@ package output
 
 import (
 	"errors"
-	"fmt"
 	"log"
 	"strings"
 
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/yoozoo/protoapi/generator/data"
 	yii2 "github.com/yoozoo/protoapi/generator/output/phpyii2"
-	"github.com/yoozoo/protoapi/util"
 )
 
 type yii2Gen struct {
+	req        *data.GenerateReq
 	result     map[string]string
 	enums      []*data.EnumData
 	ModuleName string
@@ -21,8 +19,9 @@ type yii2Gen struct {
 	comError   *data.MessageData
 }
 
-func (g *yii2Gen) Init(request *plugin.CodeGeneratorRequest) {
-	for _, file := range request.ProtoFile {
+func (g *yii2Gen) Init(request *data.GenerateReq) error {
+	g.req = request
+	for _, file := range request.Request.ProtoFile {
 		if file.GetName() == googleDescriptorProtoName {
 			continue
 		}
@@ -36,12 +35,13 @@ func (g *yii2Gen) Init(request *plugin.CodeGeneratorRequest) {
 			g.NameSpace = opts.GetPhpNamespace()
 		}
 	}
+	return nil
 }
 
 /* generate functions */
-func (g *yii2Gen) genController(methods []*data.Method) error {
-	obj := yii2.NewController(g.NameSpace, methods)
-	err := obj.Gen(g.result)
+func (g *yii2Gen) genController(prefix string, methods []*data.Method) error {
+	obj := yii2.NewController(g.NameSpace, prefix, methods)
+	err := obj.Gen(g.req, g.result)
 	if err != nil {
 		return err
 	}
@@ -50,7 +50,7 @@ func (g *yii2Gen) genController(methods []*data.Method) error {
 
 func (g *yii2Gen) genEnum(enum *data.EnumData) error {
 	obj := yii2.NewEnum(enum, g.NameSpace)
-	err := obj.Gen(g.result)
+	err := obj.Gen(g.req, g.result)
 	if err != nil {
 		return err
 	}
@@ -60,17 +60,21 @@ func (g *yii2Gen) genEnum(enum *data.EnumData) error {
 func (g *yii2Gen) genError(msg *data.MessageData) error {
 	obj := yii2.NewError(msg, g.NameSpace, g.enums)
 
-	err := obj.Gen(g.result)
+	err := obj.Gen(g.req, g.result)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (g *yii2Gen) genHandler(methods []*data.Method) error {
-	obj := yii2.NewHandler(methods, g.NameSpace)
+func (g *yii2Gen) genHandler(prefix string, methods []*data.Method) error {
+	obj := yii2.NewHandler(methods, g.NameSpace, prefix)
 
-	err := obj.Gen(g.result)
+	err := obj.GenErrorHandler(g.req, g.result)
+	if err != nil {
+		return err
+	}
+	err = obj.Gen(g.req, g.result)
 	if err != nil {
 		return err
 	}
@@ -80,16 +84,16 @@ func (g *yii2Gen) genHandler(methods []*data.Method) error {
 func (g *yii2Gen) genMessage(msg *data.MessageData) error {
 	obj := yii2.NewMessage(msg, g.NameSpace, g.enums)
 
-	err := obj.Gen(g.result)
+	err := obj.Gen(g.req, g.result)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (g *yii2Gen) genModule(service *data.ServiceData) error {
-	obj := yii2.NewModule(g.NameSpace, service)
-	err := obj.Gen(g.result)
+func (g *yii2Gen) genModule(services []*data.ServiceData, prefixes map[string]string) error {
+	obj := yii2.NewModule(g.NameSpace, services, prefixes)
+	err := obj.Gen(g.req, g.result)
 	if err != nil {
 		return err
 	}
@@ -117,11 +121,18 @@ func (g *yii2Gen) isComErr(msg *data.MessageData) bool {
 }
 
 func (g *yii2Gen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
-	var service *data.ServiceData
-	if len(services) > 1 {
-		util.Die(fmt.Errorf("found %d services; only 1 service is supported now", len(services)))
-	} else if len(services) == 1 {
-		service = services[0]
+	if len(services) == 0 {
+		return nil, errors.New("No service found")
+	}
+
+	// with several services, the controllers and handlers are prefixed by the service names
+	prefixes := make(map[string]string)
+	for _, service := range services {
+		if len(services) > 1 {
+			prefixes[service.Name] = service.Name
+		} else {
+			prefixes[service.Name] = ""
+		}
 	}
 
 	// set enums
@@ -132,7 +143,7 @@ func (g *yii2Gen) Gen(applicationName string, packageName string, services []*da
 		g.NameSpace = strings.Replace(packageName, ".", "\\", -1)
 
 		if g.NameSpace == "" {
-			util.Die(fmt.Errorf("No name space given"))
+			return nil, errors.New("No name space given")
 		}
 
 		log.Printf("Use proto package name for php: %v", g.NameSpace)
@@ -146,10 +157,12 @@ func (g *yii2Gen) Gen(applicationName string, packageName string, services []*da
 	g.result = make(map[string]string)
 
 	// create error map
-	for _, serv := range service.Methods {
-		errorMsgName, found := serv.Options["error"]
-		if found {
-			g.bizErrors = append(g.bizErrors, errorMsgName)
+	for _, service := range services {
+		for _, serv := range service.Methods {
+			errorMsgName, found := serv.Options["error"]
+			if found {
+				g.bizErrors = append(g.bizErrors, errorMsgName)
+			}
 		}
 	}
 
@@ -164,16 +177,23 @@ func (g *yii2Gen) Gen(applicationName string, packageName string, services []*da
 		return nil, errors.New("Cannot find common error message")
 	}
 
-	// call genarator functions one by one
-	err = g.genController(service.Methods)
-	if err != nil {
-		return nil, err
+	// methods taking or returning google.protobuf.Empty need a model class for it
+	if data.UsesType(services, data.EmptyType) {
+		messages = append(messages, &data.MessageData{Name: data.EmptyType})
 	}
-	err = g.genModule(service)
-	if err != nil {
-		return nil, err
+
+	// call genarator functions one by one
+	for _, service := range services {
+		err = g.genController(prefixes[service.Name], service.Methods)
+		if err != nil {
+			return nil, err
+		}
+		err = g.genHandler(prefixes[service.Name], service.Methods)
+		if err != nil {
+			return nil, err
+		}
 	}
-	err = g.genHandler(service.Methods)
+	err = g.genModule(services, prefixes)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +205,7 @@ func (g *yii2Gen) Gen(applicationName string, packageName string, services []*da
 	}
 
 	for _, msg := range messages {
-		data.FlattenLocalPackage(msg)
+		g.req.FlattenLocalPackage(msg)
 
 		if g.isBizErr(msg) {
 			err = g.genError(msg)
@@ -198,11 +218,6 @@ func (g *yii2Gen) Gen(applicationName string, packageName string, services []*da
 				return nil, err
 			}
 		} else {
-			// rename 'Empty' message to 'Blank' to avoid PHP compilation error
-			if "EMPTY" == strings.ToUpper(msg.Name) {
-				msg.Name = "Blank"
-			}
-
 			err := g.genMessage(msg)
 			if err != nil {
 				return nil, err
@@ -214,5 +229,5 @@ func (g *yii2Gen) Gen(applicationName string, packageName string, services []*da
 }
 
 func init() {
-	data.OutputMap["yii2"] = &yii2Gen{}
+	data.OutputMap["yii2"] = func() data.CodeGenerator { return &yii2Gen{} }
 }
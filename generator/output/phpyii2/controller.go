@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/yoozoo/protoapi/util"
 
@@ -11,36 +12,56 @@ import (
 )
 
 // NewController return a pointer of new controller struct
-func NewController(nameSpace string, methods []*data.Method) *Controller {
+// prefix is prepended to the class names when several services are generated
+func NewController(nameSpace string, prefix string, methods []*data.Method) *Controller {
 
 	fileDir := strings.Replace(nameSpace, "\\", "/", -1)
-	filePath := fileDir + "/controllers/ApiController.php"
+	className := prefix + "ApiController"
+	filePath := fileDir + "/controllers/" + className + ".php"
 
-	o := &Controller{nameSpace, filePath, methods}
+	o := &Controller{nameSpace, filePath, className, prefix + "RequestHandler", methods}
 	return o
 }
 
+// ControllerID returns the yii2 controller id of the controller with the given prefix
+func ControllerID(prefix string) string {
+	var buf bytes.Buffer
+	for i, c := range prefix {
+		if unicode.IsUpper(c) && i > 0 {
+			buf.WriteByte('-')
+		}
+		buf.WriteRune(unicode.ToLower(c))
+	}
+	if buf.Len() > 0 {
+		buf.WriteByte('-')
+	}
+	buf.WriteString("api")
+
+	return buf.String()
+}
+
 // Controller is struct of php Controller class
 type Controller struct {
-	NameSpace string
-	FilePath  string
-	Methods   []*data.Method
+	NameSpace   string
+	FilePath    string
+	ClassName   string
+	HandlerName string
+	Methods     []*data.Method
 }
 
 func (p *Controller) escape(s string) string {
 	return strings.Replace(s, `\`, `\\`, -1)
 }
 
-func (p *Controller) Gen(result map[string]string) error {
+func (p *Controller) Gen(req *data.GenerateReq, result map[string]string) error {
 	buf := bytes.NewBufferString("")
 
-	tplContent := data.LoadTpl("/generator/template/yii2/controllers/ApiController.gophp")
 	funcMap := template.FuncMap{
 		"escape":    p.escape,
 		"className": util.GetPHPClassName,
 		"title":     strings.Title,
 	}
-	tpl, err := template.New("Controller").Funcs(funcMap).Parse(tplContent)
+	tpl, err := req.NewTemplate("Controller", "/generator/template/yii2/controllers/ApiController.gophp", funcMap)
 	if err != nil {
 		return err
 	}
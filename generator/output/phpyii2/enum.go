@@ -13,7 +13,7 @@ import (
 func NewEnum(enum *data.EnumData, baseNameSpace string) *Enum {
 	nameSpace := baseNameSpace + "\\models"
 	filePath := strings.Replace(nameSpace, "\\", "/", -1)
-	filePath = filePath + "/" + strings.Title(enum.Name) + ".php"
+	filePath = filePath + "/" + util.GetPHPClassName(enum.Name) + ".php"
 	o := &Enum{enum, nameSpace, filePath}
 	return o
 }
@@ -25,16 +25,15 @@ type Enum struct {
 	FilePath  string
 }
 
-func (p *Enum) Gen(result map[string]string) error {
+func (p *Enum) Gen(req *data.GenerateReq, result map[string]string) error {
 	buf := bytes.NewBufferString("")
 
-	tplContent := data.LoadTpl("/generator/template/yii2/models/enum.gophp")
-
 	funcMap := template.FuncMap{
 		"className": util.GetPHPClassName,
+		"constName": util.GetPHPConstName,
 	}
 
-	tpl, err := template.New("Enum").Funcs(funcMap).Parse(tplContent)
+	tpl, err := req.NewTemplate("Enum", "/generator/template/yii2/models/enum.gophp", funcMap)
 	if err != nil {
 		return err
 	}
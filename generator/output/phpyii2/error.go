@@ -13,7 +13,7 @@ import (
 func NewError(msg *data.MessageData, baseNameSpace string, enums []*data.EnumData) *Error {
 	nameSpace := baseNameSpace + "\\models"
 	filePath := strings.Replace(nameSpace, "\\", "/", -1)
-	filePath = filePath + "/" + strings.Title(msg.Name) + ".php"
+	filePath = filePath + "/" + util.GetPHPClassName(msg.Name) + ".php"
 	o := &Error{msg, nameSpace, filePath, enums}
 	return o
 }
@@ -27,34 +27,28 @@ type Error struct {
 }
 
 func (p *Error) IsObject(fieldType string) bool {
-	switch fieldType {
-	case data.StringFieldType,
-		data.DoubleFieldType,
-		data.IntFieldType,
-		data.BooleanFieldType:
+	// scalar and well-known types are plain JSON values
+	if data.IsScalarType(fieldType) || data.IsWellKnownType(fieldType) {
 		return false
-	default:
-		// check if is enum
-		for _, enum := range p.Enums {
-			if enum.Name == fieldType {
-				return false
-			}
+	}
+	// check if is enum
+	for _, enum := range p.Enums {
+		if enum.Name == fieldType {
+			return false
 		}
-		return true
 	}
+	return true
 }
 
-func (p *Error) Gen(result map[string]string) error {
+func (p *Error) Gen(req *data.GenerateReq, result map[string]string) error {
 	buf := bytes.NewBufferString("")
 
-	tplContent := data.LoadTpl("/generator/template/yii2/models/error.gophp")
-
 	funcMap := template.FuncMap{
 		"isObject":  p.IsObject,
 		"className": util.GetPHPClassName,
 	}
 
-	tpl, err := template.New("Error").Funcs(funcMap).Parse(tplContent)
+	tpl, err := req.NewTemplate("Error", "/generator/template/yii2/models/error.gophp", funcMap)
 	if err != nil {
 		return err
 	}
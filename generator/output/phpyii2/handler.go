@@ -10,9 +10,10 @@ import (
 )
 
 // NewHandler return a pointer of new handler struct
-func NewHandler(methods []*data.Method, baseNameSpace string) *Handler {
+// prefix is prepended to the class name when several services are generated
+func NewHandler(methods []*data.Method, baseNameSpace string, prefix string) *Handler {
 	nameSpace := baseNameSpace + "\\handlers"
-	o := &Handler{methods, nameSpace, baseNameSpace}
+	o := &Handler{methods, nameSpace, baseNameSpace, prefix + "RequestHandler"}
 	return o
 }
 
@@ -21,17 +22,15 @@ type Handler struct {
 	Methods       []*data.Method
 	NameSpace     string
 	BaseNameSpace string
+	ClassName     string
 }
 
-func (p *Handler) Gen(result map[string]string) error {
+// GenErrorHandler generates the error handler shared by all the request handlers
+func (p *Handler) GenErrorHandler(req *data.GenerateReq, result map[string]string) error {
 	filePath := strings.Replace(p.NameSpace, "\\", "/", -1)
-	funcMap := template.FuncMap{
-		"className": util.GetPHPClassName,
-	}
 
 	buf := bytes.NewBufferString("")
-	errorHandlerTplContent := data.LoadTpl("/generator/template/yii2/handlers/ErrorHandler.gophp")
-	tpl, err := template.New("error handler").Funcs(funcMap).Parse(errorHandlerTplContent)
+	tpl, err := req.NewTemplate("error handler", "/generator/template/yii2/handlers/ErrorHandler.gophp", nil)
 	if err != nil {
 		return err
 	}
@@ -41,9 +40,17 @@ func (p *Handler) Gen(result map[string]string) error {
 	}
 	result[filePath+"/ErrorHandler.php"] = buf.String()
 
-	buf = bytes.NewBufferString("")
-	requestHandlerTplContent := data.LoadTpl("/generator/template/yii2/handlers/RequestHandler.gophp")
-	tpl, err = template.New("request handler").Funcs(funcMap).Parse(requestHandlerTplContent)
+	return nil
+}
+
+func (p *Handler) Gen(req *data.GenerateReq, result map[string]string) error {
+	filePath := strings.Replace(p.NameSpace, "\\", "/", -1)
+	funcMap := template.FuncMap{
+		"className": util.GetPHPClassName,
+	}
+
+	buf := bytes.NewBufferString("")
+	tpl, err := req.NewTemplate("request handler", "/generator/template/yii2/handlers/RequestHandler.gophp", funcMap)
 	if err != nil {
 		return err
 	}
@@ -51,7 +58,7 @@ func (p *Handler) Gen(result map[string]string) error {
 	if err != nil {
 		return err
 	}
-	result[filePath+"/RequestHandler.php"] = buf.String()
+	result[filePath+"/"+p.ClassName+".php"] = buf.String()
 
 	return nil
 }
@@ -14,7 +14,7 @@ import (
 func NewMessage(msg *data.MessageData, baseNameSpace string, enums []*data.EnumData) *Message {
 	nameSpace := baseNameSpace + "\\models"
 	filePath := strings.Replace(nameSpace, "\\", "/", -1)
-	filePath = filePath + "/" + strings.Title(msg.Name) + ".php"
+	filePath = filePath + "/" + util.GetPHPClassName(msg.Name) + ".php"
 
 	o := &Message{msg, nameSpace, filePath, enums}
 	return o
@@ -29,34 +29,36 @@ type Message struct {
 }
 
 func (p *Message) IsObject(fieldType string) bool {
-	switch fieldType {
-	case data.StringFieldType,
-		data.DoubleFieldType,
-		data.IntFieldType,
-		data.BooleanFieldType:
+	// scalar and well-known types are plain JSON values
+	if data.IsScalarType(fieldType) || data.IsWellKnownType(fieldType) {
 		return false
-	default:
-		// check if is enum
-		for _, enum := range p.Enums {
-			if enum.Name == fieldType {
-				return false
-			}
+	}
+	// check if is enum
+	for _, enum := range p.Enums {
+		if enum.Name == fieldType {
+			return false
 		}
-		return true
 	}
+	return true
 }
 
-func (p *Message) Gen(result map[string]string) error {
-	buf := bytes.NewBufferString("")
+// IsNullable returns if the field may be null, as the wrapper well-known types
+func (p *Message) IsNullable(fieldType string) bool {
+	_, ok := data.WrapperTypes[fieldType]
+	return ok || fieldType == data.ValueType
+}
 
-	tplContent := data.LoadTpl("/generator/template/yii2/models/message.gophp")
+func (p *Message) Gen(req *data.GenerateReq, result map[string]string) error {
+	buf := bytes.NewBufferString("")
 
 	funcMap := template.FuncMap{
-		"isObject":  p.IsObject,
-		"className": util.GetPHPClassName,
+		"isObject":   p.IsObject,
+		"isNullable": p.IsNullable,
+		"className":  util.GetPHPClassName,
+		"phpRegex":   util.GetPHPRegex,
 	}
 
-	tpl, err := template.New("message").Funcs(funcMap).Parse(tplContent)
+	tpl, err := req.NewTemplate("message", "/generator/template/yii2/models/message.gophp", funcMap)
 	if err != nil {
 		return err
 	}
@@ -11,8 +11,9 @@ import (
 )
 
 // NewModule return a pointer of new module struct
-func NewModule(NameSpace string, service *data.ServiceData) *Module {
-	o := &Module{NameSpace, service}
+// the module is named after the first service, prefixes map the services to their class name prefixes
+func NewModule(NameSpace string, services []*data.ServiceData, prefixes map[string]string) *Module {
+	o := &Module{NameSpace, services[0], services, prefixes}
 	return o
 }
 
@@ -20,14 +21,28 @@ func NewModule(NameSpace string, service *data.ServiceData) *Module {
 type Module struct {
 	NameSpace string
 	Service   *data.ServiceData
+	Services  []*data.ServiceData
+	prefixes  map[string]string
 }
 
-func (p *Module) Gen(result map[string]string) error {
+// requestHandler is the data of the RequestHandler implementation of a service
+type requestHandler struct {
+	NameSpace string
+	ClassName string
+	Service   *data.ServiceData
+}
+
+func (p *Module) controllerID(service string) string {
+	return ControllerID(p.prefixes[service])
+}
+
+func (p *Module) Gen(req *data.GenerateReq, result map[string]string) error {
 	filePath := strings.Replace(p.NameSpace, "\\", "/", -1)
 
 	buf := bytes.NewBufferString("")
-	tplContent := data.LoadTpl("/generator/template/yii2/Module.gophp")
-	tpl, err := template.New("Module").Parse(tplContent)
+	tpl, err := req.NewTemplate("Module", "/generator/template/yii2/Module.gophp", template.FuncMap{
+		"controllerID": p.controllerID,
+	})
 	if err != nil {
 		return err
 	}
@@ -37,20 +52,22 @@ func (p *Module) Gen(result map[string]string) error {
 	}
 	result[filePath+"/Module.php"] = buf.String()
 
-	buf = bytes.NewBufferString("")
-	tplContent = data.LoadTpl("/generator/template/yii2/RequestHandler.gophp")
 	funcMap := template.FuncMap{
 		"className": util.GetPHPClassName,
 	}
-	tpl, err = template.New("Module").Funcs(funcMap).Parse(tplContent)
+	tpl, err = req.NewTemplate("Module", "/generator/template/yii2/RequestHandler.gophp", funcMap)
 	if err != nil {
 		return err
 	}
-	err = tpl.Execute(buf, p)
-	if err != nil {
-		return err
+	for _, service := range p.Services {
+		handler := &requestHandler{p.NameSpace, p.prefixes[service.Name] + "RequestHandler", service}
+		buf = bytes.NewBufferString("")
+		err = tpl.Execute(buf, handler)
+		if err != nil {
+			return err
+		}
+		result[filePath+"/"+handler.ClassName+".php"] = buf.String()
 	}
-	result[filePath+"/RequestHandler.php"] = buf.String()
 
 	return nil
 }
@@ -3,10 +3,10 @@ package output
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"text/template"
 
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/yoozoo/protoapi/generator/data"
 	"github.com/yoozoo/protoapi/util"
 )
@@ -27,7 +27,8 @@ var javaTypes = map[string]string{
 	"sfixed64": "long",
 	"bool":     "boolean",
 	"string":   "String",
-	"bytes":    "ByteString",
+	// jackson reads and writes byte[] as base64 strings
+	"bytes": "byte[]",
 }
 
 var wrapperTypes = map[string]string{
@@ -45,11 +46,55 @@ var wrapperTypes = map[string]string{
 	"sfixed64": "Long",
 	"bool":     "Boolean",
 	"string":   "String",
-	"bytes":    "Byte",
-	"int":      "Integer",
+	"bytes":    "byte[]",
+}
+
+// javaWellKnownTypes is the map of well-known types and the java types they are mapped to
+var javaWellKnownTypes = map[string]string{
+	data.TimestampType:   "Instant",
+	data.DurationType:    "Duration",
+	data.EmptyType:       "Map<String, Object>",
+	data.StructType:      "Map<String, Object>",
+	data.ValueType:       "Object",
+	data.ListValueType:   "List<Object>",
+	data.DoubleValueType: "Double",
+	data.FloatValueType:  "Float",
+	data.Int64ValueType:  "Long",
+	data.UInt64ValueType: "Long",
+	data.Int32ValueType:  "Integer",
+	data.UInt32ValueType: "Integer",
+	data.BoolValueType:   "Boolean",
+	data.StringValueType: "String",
+	data.BytesValueType:  "byte[]",
+}
+
+// javaWellKnownImports is the map of well-known types and the import their java types need
+var javaWellKnownImports = map[string]string{
+	data.TimestampType: "java.time.Instant",
+	data.DurationType:  "java.time.Duration",
+	data.EmptyType:     "java.util.Map",
+	data.StructType:    "java.util.Map",
+	data.ListValueType: "java.util.List",
+}
+
+// javaImports returns the sorted imports needed by the given data types, besides the always imported ones
+func javaImports(imports []string, dataTypes ...string) []string {
+	for _, dataType := range dataTypes {
+		if imp, ok := javaWellKnownImports[dataType]; ok && !util.IsStrInSlice(imp, imports) {
+			imports = append(imports, imp)
+		}
+	}
+	sort.Strings(imports)
+	return imports
 }
 
 func toJavaType(dataType string, label string) string {
+	if wellKnownType, ok := javaWellKnownTypes[dataType]; ok {
+		if label == data.FieldRepeatedLabel {
+			return "List<" + wellKnownType + ">"
+		}
+		return wellKnownType
+	}
 	// check if the field is repeated
 	if label == data.FieldRepeatedLabel {
 		// check if wrapper type
@@ -67,57 +112,70 @@ func toJavaType(dataType string, label string) string {
 	return dataType
 }
 
+func toJavaMapType(keyType string, dataType string) string {
+	// map key and value must be object types
+	if wrapperType, ok := wrapperTypes[keyType]; ok {
+		keyType = wrapperType
+	}
+	if wrapperType, ok := wrapperTypes[dataType]; ok {
+		dataType = wrapperType
+	} else if wellKnownType, ok := javaWellKnownTypes[dataType]; ok {
+		dataType = wellKnownType
+	}
+	return "Map<" + keyType + ", " + dataType + ">"
+}
+
 type springGen struct {
+	req             *data.GenerateReq
 	ApplicationName string
 	PackageName     string
 	structTpl       *template.Template
 	serviceTpl      *template.Template
+	durationTpl     *template.Template
 }
 
-func (g *springGen) getTpl(path string) *template.Template {
-	var err error
-	tpl := template.New("tpl")
-	tplStr := data.LoadTpl(path)
-	result, err := tpl.Parse(tplStr)
-	if err != nil {
-		panic(err)
-	}
-	return result
+func (g *springGen) getTpl(path string) (*template.Template, error) {
+	return g.req.NewTemplate("tpl", path, nil)
 }
 
-func (g *springGen) init(applicationName, packageName string) {
+func (g *springGen) init(applicationName, packageName string) (err error) {
 	g.ApplicationName = applicationName
 	g.PackageName = packageName
-	g.structTpl = g.getTpl("/generator/template/spring_struct.gojava")
-	g.serviceTpl = g.getTpl("/generator/template/spring_service.gojava")
+	if g.structTpl, err = g.getTpl("/generator/template/spring_struct.gojava"); err != nil {
+		return
+	}
+	if g.serviceTpl, err = g.getTpl("/generator/template/spring_service.gojava"); err != nil {
+		return
+	}
+	g.durationTpl, err = g.getTpl("/generator/template/spring_duration.gojava")
+	return
 }
 
 func (g *springGen) getStructFilename(packageName string, msg *data.MessageData) string {
-	data.FlattenLocalPackage(msg)
+	g.req.FlattenLocalPackage(msg)
 
 	return strings.Replace(packageName, ".", "/", -1) + "/" + msg.Name + ".java"
 }
 
-func (g *springGen) genStruct(msg *data.MessageData) string {
+func (g *springGen) genStruct(obj *springStruct) (string, error) {
 	buf := bytes.NewBufferString("")
 
-	obj := newSpringStruct(msg, g.PackageName)
 	err := g.structTpl.Execute(buf, obj)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	return buf.String()
+	return buf.String(), nil
 }
 
-func (g *springGen) genServie(service *data.ServiceData) string {
+func (g *springGen) genServie(service *data.ServiceData) (string, error) {
 	buf := bytes.NewBufferString("")
 
 	obj := newSpringService(service, g.PackageName)
 	err := g.serviceTpl.Execute(buf, obj)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	return buf.String()
+	return buf.String(), nil
 }
 
 func genSpringPackageName(packageName string, options data.OptionMap) string {
@@ -132,37 +190,54 @@ func (g *springGen) genServiceFileName(packageName string, service *data.Service
 	return strings.Replace(packageName, ".", "/", -1) + "/" + service.Name + "Base.java"
 }
 
-func (g *springGen) Init(request *plugin.CodeGeneratorRequest) {
+func (g *springGen) Init(request *data.GenerateReq) error {
+	g.req = request
+	return nil
 }
 
 func (g *springGen) Gen(applicationName string, packageName string, services []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (result map[string]string, err error) {
-	var service *data.ServiceData
-	if len(services) > 1 {
-		util.Die(fmt.Errorf("found %d services; only 1 service is supported now", len(services)))
-	} else if len(services) == 1 {
-		service = services[0]
-	}
-
 	// get java package name from options
 	packageName = genSpringPackageName(packageName, options)
-	g.init(applicationName, packageName)
+	if err = g.init(applicationName, packageName); err != nil {
+		return
+	}
 	result = make(map[string]string)
 
+	hasDuration := false
 	for _, msg := range messages {
 		filename := g.getStructFilename(packageName, msg)
-		content := g.genStruct(msg)
+		obj := newSpringStruct(msg, g.PackageName)
+		content, err := g.genStruct(obj)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", msg.Name, err)
+		}
+		hasDuration = hasDuration || obj.HasDuration()
 
 		result[filename] = content
 	}
 
-	// make file name same as java class name
-	filename := g.genServiceFileName(packageName, service)
-	content := g.genServie(service)
-	result[filename] = content
+	// durations need their own jackson (de)serializer to follow proto3 JSON
+	if hasDuration {
+		buf := bytes.NewBufferString("")
+		if err := g.durationTpl.Execute(buf, g); err != nil {
+			return nil, err
+		}
+		result[strings.Replace(packageName, ".", "/", -1)+"/DurationJson.java"] = buf.String()
+	}
+
+	for _, service := range services {
+		// make file name same as java class name
+		filename := g.genServiceFileName(packageName, service)
+		content, err := g.genServie(service)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", service.Name, err)
+		}
+		result[filename] = content
+	}
 
 	return
 }
 
 func init() {
-	data.OutputMap["spring"] = &springGen{}
+	data.OutputMap["spring"] = func() data.CodeGenerator { return &springGen{} }
 }
@@ -1,7 +1,12 @@
 package output
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+
 	"github.com/yoozoo/protoapi/generator/data"
+	"github.com/yoozoo/protoapi/util"
 )
 
 type springMethod struct {
@@ -9,16 +14,60 @@ type springMethod struct {
 	ServiceName string
 }
 
-func (m *springMethod) Path() string {
-	return "/" + m.ServiceName + "." + m.Name
+// springMapping is the request mapping of a method for one binding
+type springMapping struct {
+	Annotation string         // mapping annotation without the path, ie GetMapping
+	Suffix     string         // suffix of the handler name, ie Get
+	HasBody    bool           // the input is read from the request body instead of the parameters
+	PathParams []*springField // input fields bound from the path variables
+}
+
+// springMappings maps the HTTP verbs to their mapping annotations, HEAD has no dedicated one
+var springMappings = map[string]springMapping{
+	data.HTTPGet:    {"GetMapping", "Get", false, nil},
+	data.HTTPHead:   {"RequestMapping", "Head", false, nil},
+	data.HTTPPost:   {"PostMapping", "Post", true, nil},
+	data.HTTPPut:    {"PutMapping", "Put", true, nil},
+	data.HTTPPatch:  {"PatchMapping", "Patch", true, nil},
+	data.HTTPDelete: {"DeleteMapping", "Delete", false, nil},
 }
 
-func (m *springMethod) ServiceType() string {
-	if servType, ok := m.Options[data.MethodOptions[data.ServiceTypeMethodOption].Name]; ok {
-		return servType
+// Mappings returns the request mappings of the bindings of the method, the handlers of
+// the bindings sharing a verb are numbered, ie findGet and findGet2
+func (m *springMethod) Mappings() []*springMapping {
+	var result []*springMapping
+	count := make(map[string]int)
+	for _, binding := range m.Bindings {
+		mapping := springMappings[binding.HttpMtd]
+		if binding.HttpMtd == data.HTTPHead {
+			mapping.Annotation = fmt.Sprintf("RequestMapping(value = %q, method = RequestMethod.HEAD)", binding.Path)
+		} else {
+			mapping.Annotation = fmt.Sprintf("%s(%q)", mapping.Annotation, binding.Path)
+		}
+		if count[binding.HttpMtd]++; count[binding.HttpMtd] > 1 {
+			mapping.Suffix += strconv.Itoa(count[binding.HttpMtd])
+		}
+		for _, param := range binding.PathParams {
+			mapping.PathParams = append(mapping.PathParams, &springField{param})
+		}
+		result = append(result, &mapping)
 	}
+	return result
+}
+
+// JavaName returns the name of the abstract method implementing the method
+func (m *springMethod) JavaName() string {
+	return util.SafeJavaName(m.Name)
+}
+
+// InputJavaType returns the java type of the method input
+func (m *springMethod) InputJavaType() string {
+	return toJavaType(m.InputType, "")
+}
 
-	return "POST"
+// OutputJavaType returns the java type of the method output
+func (m *springMethod) OutputJavaType() string {
+	return toJavaType(m.OutputType, "")
 }
 
 type springService struct {
@@ -44,3 +93,65 @@ func (s *springService) init() {
 		s.Methods[i] = &springMethod{mtd, s.Name}
 	}
 }
+
+// MappingImports returns the mapping annotations used by the methods, GetMapping and PostMapping are always imported
+func (s *springService) MappingImports() []string {
+	imports := []string{"GetMapping", "PostMapping"}
+	for _, verb := range data.HTTPMethods {
+		for _, m := range s.Methods {
+			if !util.IsStrInSlice(verb, m.HttpMtds) {
+				continue
+			}
+			if annotation := springMappings[verb].Annotation; !util.IsStrInSlice(annotation, imports) {
+				imports = append(imports, annotation)
+			}
+			if verb == data.HTTPHead {
+				imports = append(imports, "RequestMethod")
+			}
+			break
+		}
+	}
+	return imports
+}
+
+// Imports returns the java imports needed by the method types of the service
+// and by the binding of the path parameters
+func (s *springService) Imports() []string {
+	var imports []string
+	for _, m := range s.Methods {
+		imports = javaImports(imports, m.InputType, m.OutputType)
+	}
+	if s.HasPathParams() {
+		for _, imp := range springPathParamImports {
+			if !util.IsStrInSlice(imp, imports) {
+				imports = append(imports, imp)
+			}
+		}
+		sort.Strings(imports)
+	}
+	return imports
+}
+
+// springPathParamImports are the imports needed to merge the path parameters into the input
+var springPathParamImports = []string{
+	"com.fasterxml.jackson.core.JsonProcessingException",
+	"com.fasterxml.jackson.databind.ObjectMapper",
+	"com.fasterxml.jackson.databind.node.ObjectNode",
+	"java.util.Map",
+	"org.springframework.beans.factory.annotation.Autowired",
+	"org.springframework.web.bind.annotation.PathVariable",
+	"org.springframework.web.bind.annotation.RequestParam",
+}
+
+// HasPathParams returns if any method binds input fields from the path, the inputs of
+// these methods are merged with the path parameters by the object mapper
+func (s *springService) HasPathParams() bool {
+	for _, m := range s.Methods {
+		for _, binding := range m.Bindings {
+			if len(binding.PathParams) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -4,25 +4,73 @@ import (
 	"strings"
 
 	"github.com/yoozoo/protoapi/generator/data"
+	"github.com/yoozoo/protoapi/util"
 )
 
 type springField struct {
 	*data.MessageField
 }
 
+// Title returns the name in the accessors, getClass is final in Object so class gets getClass_
 func (s *springField) Title() string {
+	if s.Name == "class" {
+		return "Class_"
+	}
 	return strings.Title(s.Name)
 }
 
+// JavaName returns the name of the field and the parameters holding it
+func (s *springField) JavaName() string {
+	return util.SafeJavaName(s.Name)
+}
+
 func (s *springField) JavaType() string {
+	if s.IsMap() {
+		return toJavaMapType(s.MessageField.KeyType, s.MessageField.DataType)
+	}
+	if s.Oneof != "" || s.Optional {
+		// members of oneof groups and optional fields are null when not set
+		if wrapperType, ok := wrapperTypes[s.MessageField.DataType]; ok {
+			return wrapperType
+		}
+	}
 	return toJavaType(s.MessageField.DataType, s.MessageField.Label)
 }
 
+// IsDuration returns if the field holds durations, which need the proto3 JSON (de)serializer
+func (s *springField) IsDuration() bool {
+	return s.DataType == data.DurationType
+}
+
+// DurationUsing returns the jackson annotation attribute applying the duration (de)serializer
+func (s *springField) DurationUsing() string {
+	if s.IsMap() || s.Label == data.FieldRepeatedLabel {
+		return "contentUsing"
+	}
+	return "using"
+}
+
+// springOneof a oneof group rendered as an abstract holder class with one subclass per member
+type springOneof struct {
+	*data.OneofData
+	Fields []*springField
+}
+
+func (o *springOneof) Title() string {
+	return strings.Title(o.Name)
+}
+
+// JavaName returns the name of the field holding the oneof
+func (o *springOneof) JavaName() string {
+	return util.SafeJavaName(o.Name)
+}
+
 func newSpringStruct(msg *data.MessageData, packageName string) *springStruct {
 	o := &springStruct{
 		msg,
 		packageName,
 		nil,
+		nil,
 	}
 	o.init()
 	return o
@@ -32,6 +80,7 @@ type springStruct struct {
 	*data.MessageData
 	Package string
 	Fields  []*springField
+	Oneofs  []*springOneof
 }
 
 func (s *springStruct) init() {
@@ -39,12 +88,50 @@ func (s *springStruct) init() {
 	for i, f := range s.MessageData.Fields {
 		s.Fields[i] = &springField{f}
 	}
+	for _, o := range s.MessageData.Oneofs {
+		oneof := &springOneof{o, nil}
+		for _, f := range s.Fields {
+			if f.Oneof == o.Name {
+				oneof.Fields = append(oneof.Fields, f)
+			}
+		}
+		s.Oneofs = append(s.Oneofs, oneof)
+	}
+}
+
+// Imports returns the java imports needed by the fields of the struct
+func (s *springStruct) Imports() []string {
+	var imports []string
+	for _, f := range s.Fields {
+		if strings.Contains(f.JavaType(), "List<") && !util.IsStrInSlice("java.util.List", imports) {
+			imports = append(imports, "java.util.List")
+		}
+		if f.IsMap() && !util.IsStrInSlice("java.util.Map", imports) {
+			imports = append(imports, "java.util.Map")
+		}
+		imports = javaImports(imports, f.DataType)
+	}
+	return imports
+}
+
+// HasDuration returns if any field of the struct holds durations
+func (s *springStruct) HasDuration() bool {
+	for _, f := range s.Fields {
+		if f.IsDuration() {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *springStruct) ContructParam() string {
 	params := make([]string, len(s.Fields))
 	for i, f := range s.Fields {
-		params[i] = "@JsonProperty(\"" + f.Name + "\") " + f.JavaType() + " " + f.Name
+		params[i] = "@JsonProperty(\"" + f.Key + "\") "
+		if f.IsDuration() {
+			params[i] += "@JsonDeserialize(" + f.DurationUsing() + " = DurationJson.Deserializer.class) "
+		}
+		params[i] += f.JavaType() + " " + f.JavaName()
 	}
 	return strings.Join(params, ", ")
 }
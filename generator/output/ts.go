@@ -3,20 +3,19 @@ package output
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/yoozoo/protoapi/generator/data"
 	"github.com/yoozoo/protoapi/util"
-
-	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 )
 
 /**
 *  Map go type to ts types
+*  the 64-bit integers are JSON numbers, exact up to 2^53 in ts
  */
 var tsTypes = map[string]string{
-	"int":      "number",
 	"double":   "number",
 	"float":    "number",
 	"int32":    "number",
@@ -31,42 +30,118 @@ var tsTypes = map[string]string{
 	"sfixed64": "number",
 	"bool":     "boolean",
 	"string":   "string",
+	// bytes are base64 strings in JSON
+	"bytes": "string",
+}
+
+// tsWellKnownTypes is the map of well-known types and their ts types in proto3 JSON
+var tsWellKnownTypes = map[string]string{
+	data.TimestampType:   "string",
+	data.DurationType:    "string",
+	data.EmptyType:       "{}",
+	data.StructType:      "{ [key: string]: any }",
+	data.ValueType:       "any",
+	data.ListValueType:   "any[]",
+	data.DoubleValueType: "number | null",
+	data.FloatValueType:  "number | null",
+	data.Int64ValueType:  "number | null",
+	data.UInt64ValueType: "number | null",
+	data.Int32ValueType:  "number | null",
+	data.UInt32ValueType: "number | null",
+	data.BoolValueType:   "boolean | null",
+	data.StringValueType: "string | null",
+	data.BytesValueType:  "string | null",
 }
 
 type tsGen struct {
+	req       *data.GenerateReq
 	DataTypes []*data.MessageData
 	Lib       tsLibs
 
+	objsName   string
 	objsFile   string
 	helperFile string
 
-	axiosFile string
-	fetchFile string
-
 	objsTpl   *template.Template
 	helperTpl *template.Template
 
 	axiosTpl *template.Template
 	fetchTpl *template.Template
 
-	service *data.ServiceData
+	service  *data.ServiceData
+	services []*data.ServiceData
+
+	// HasStreaming tells if the helper reading the Server-Sent Events is needed
+	HasStreaming bool
 }
 
 type tsStruct struct {
 	ClassName string
+	ObjsName  string
 	DataTypes []*data.MessageData
 	Enums     []*data.EnumData
 	Functions []*data.Method
 	Gen       *tsGen
+	// Deprecated tells if the service is deprecated, all its functions are then
+	Deprecated bool
+	// CommonErrorMapper maps the common errors of the service when they are not the ones the helper maps
+	CommonErrorMapper string
+}
+
+// tsCommonError is a common_error type of the services, the 420 responses are mapped to its fields by Mapper
+type tsCommonError struct {
+	Type   string
+	Mapper string
+	Fields []*data.MessageField
+}
+
+// SubTypes returns the union of the types the common error is mapped to
+func (e *tsCommonError) SubTypes() string {
+	var fieldTypes []string
+	for _, f := range e.Fields {
+		fieldTypes = append(fieldTypes, " | "+toTypeScriptType(f.DataType))
+	}
+	return strings.Join(fieldTypes, "")
+}
+
+// HasStreaming tells if some functions are server-streaming methods
+func (s tsStruct) HasStreaming() bool {
+	for _, m := range s.Functions {
+		if m.ServerStreaming {
+			return true
+		}
+	}
+	return false
 }
 
 func toTypeScriptType(dataType string) string {
 	if primaryType, ok := tsTypes[dataType]; ok {
 		return primaryType
 	}
+	if wellKnownType, ok := tsWellKnownTypes[dataType]; ok {
+		return wellKnownType
+	}
 	return dataType
 }
 
+// toTypeScriptArrayType returns the ts type of a repeated field
+func toTypeScriptArrayType(dataType string) string {
+	tsType := toTypeScriptType(dataType)
+	if strings.Contains(tsType, "|") {
+		return "(" + tsType + ")[]"
+	}
+	return tsType + "[]"
+}
+
+// toTypeScriptKeyType returns the index signature type for a map key,
+// numeric keys are indexed by number, other keys by string
+func toTypeScriptKeyType(keyType string) string {
+	if toTypeScriptType(keyType) == "number" {
+		return "number"
+	}
+	return "string"
+}
+
 func getErrorType(options data.OptionMap) string {
 	if errType, ok := options["error"]; ok {
 		return errType
@@ -75,24 +150,28 @@ func getErrorType(options data.OptionMap) string {
 	return ""
 }
 
-func getServiceMtd(options data.OptionMap) string {
-	if servMtd, ok := options["service_method"]; ok {
-		return servMtd
+// tsURL returns the TypeScript expression of the url of a method, the path parameters are read from params
+func tsURL(m *data.Method) string {
+	url := "baseUrl"
+	for _, part := range m.PathParts() {
+		if part.Param != nil {
+			url += " + encodeURIComponent(String(params." + part.Param.Key + "))"
+		} else {
+			url += " + " + strconv.Quote(part.Text)
+		}
 	}
-
-	return "POST"
+	return url
 }
 
 func getImportDataTypes(mtds []*data.Method) map[string]bool {
 	res := make(map[string]bool)
 
 	for _, mtd := range mtds {
-		_, exist := res[mtd.InputType]
-		if !exist {
+		// well-known types are not defined in the objs file
+		if !data.IsWellKnownType(mtd.InputType) {
 			res[mtd.InputType] = true
 		}
-		_, exist = res[mtd.OutputType]
-		if !exist {
+		if !data.IsWellKnownType(mtd.OutputType) {
 			res[mtd.OutputType] = true
 		}
 	}
@@ -106,44 +185,47 @@ func genFileName(packageName string, fileName string) string {
 /**
 * Get TEMPLATE
  */
-func (g *tsGen) loadTpl() {
-	g.axiosTpl = g.getTpl("/generator/template/ts/service_axios.gots")
-	g.fetchTpl = g.getTpl("/generator/template/ts/service_fetch.gots")
-	g.objsTpl = g.getTpl("/generator/template/ts/objs.gots")
-	g.helperTpl = g.getTpl("/generator/template/ts/helper.gots")
+func (g *tsGen) loadTpl() (err error) {
+	if g.axiosTpl, err = g.getTpl("/generator/template/ts/service_axios.gots"); err != nil {
+		return
+	}
+	if g.fetchTpl, err = g.getTpl("/generator/template/ts/service_fetch.gots"); err != nil {
+		return
+	}
+	if g.objsTpl, err = g.getTpl("/generator/template/ts/objs.gots"); err != nil {
+		return
+	}
+	g.helperTpl, err = g.getTpl("/generator/template/ts/helper.gots")
+	return
 }
 
 /**
 * Parse TEMPLATE
  */
-func (g *tsGen) getTpl(path string) *template.Template {
+func (g *tsGen) getTpl(path string) (*template.Template, error) {
 	var funcs = template.FuncMap{
 		"tsType":             toTypeScriptType,
+		"tsArrayType":        toTypeScriptArrayType,
+		"tsKeyType":          toTypeScriptKeyType,
 		"toLower":            strings.ToLower,
 		"getErrorType":       getErrorType,
-		"getServiceMtd":      getServiceMtd,
+		"tsURL":              tsURL,
+		"tsName":             util.SafeTSName,
 		"getImportDataTypes": getImportDataTypes,
 	}
-	var err error
-	tpl := template.New("tpl").Funcs(funcs)
-	tplStr := data.LoadTpl(path)
-	result, err := tpl.Parse(tplStr)
-	if err != nil {
-		panic(err)
-	}
-	return result
+	return g.req.NewTemplate("tpl", path, funcs)
 }
 
 /**
 * load CONTENT into TEMPLATE
  */
-func (g *tsGen) genContent(tpl *template.Template, data tsStruct) string {
+func (g *tsGen) genContent(tpl *template.Template, data tsStruct) (string, error) {
 	buf := bytes.NewBufferString("")
 	err := tpl.Execute(buf, data)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	return buf.String()
+	return buf.String(), nil
 }
 
 func (g *tsGen) CommonError() string {
@@ -175,15 +257,60 @@ func (g *tsGen) HasCommonError() bool {
 	return ok
 }
 
+// CommonErrorMapper returns the name of the function errorHandling maps the common errors with by default
+func (g *tsGen) CommonErrorMapper() string {
+	return commonErrorMapper(g.CommonError())
+}
+
+func commonErrorMapper(commonError string) string {
+	return "map" + commonError[strings.LastIndex(commonError, ".")+1:] + "Type"
+}
+
+// CommonErrors returns the common errors of all the services, each one once
+func (g *tsGen) CommonErrors() []*tsCommonError {
+	var result []*tsCommonError
+	seen := make(map[string]bool)
+	for _, service := range g.services {
+		commonError, ok := service.Options["common_error"]
+		if !ok || seen[commonError] {
+			continue
+		}
+		seen[commonError] = true
+
+		e := &tsCommonError{Type: commonError, Mapper: commonErrorMapper(commonError)}
+		for _, t := range g.DataTypes {
+			if t.Name == commonError {
+				e.Fields = t.Fields
+			}
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
 /**
 * init filename with path
+* the models are shared by all the services, and named after the first service
  */
-func (g *tsGen) initFiles(packageName string, service *data.ServiceData) {
-	g.axiosFile = genFileName(packageName, service.Name)
-	g.fetchFile = genFileName(packageName, service.Name)
-	g.objsFile = genFileName(packageName, service.Name+"Objs")
+func (g *tsGen) initFiles(applicationName string, packageName string, services []*data.ServiceData) {
+	g.objsName = applicationName + "Objs"
+	g.service = &data.ServiceData{}
+	g.services = services
+	if len(services) > 0 {
+		g.objsName = services[0].Name + "Objs"
+		g.service = services[0]
+	}
+	// the helper handles the common error of the first service defining one,
+	// the services with another common error pass their own mapper
+	for _, service := range services {
+		if _, ok := service.Options["common_error"]; ok {
+			g.service = service
+			break
+		}
+	}
+
+	g.objsFile = genFileName(packageName, g.objsName)
 	g.helperFile = genFileName(packageName, "helper")
-	g.service = service
 }
 
 type tsLibs int
@@ -193,46 +320,73 @@ const (
 	tsLibAxios
 )
 
-func (g *tsGen) Init(request *plugin.CodeGeneratorRequest) {
-	g.loadTpl()
+// SupportsServerStreaming implements data.StreamingGenerator, the clients return async iterators of the responses
+func (g *tsGen) SupportsServerStreaming() bool {
+	return true
 }
 
-func (g *tsGen) Gen(applicationName string, packageName string, svrs []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (map[string]string, error) {
-	var svr *data.ServiceData
-	if len(svrs) > 1 {
-		util.Die(fmt.Errorf("found %d services; only 1 service is supported now", len(svrs)))
-	} else if len(svrs) == 1 {
-		svr = svrs[0]
-	}
+func (g *tsGen) Init(request *data.GenerateReq) error {
+	g.req = request
+	return g.loadTpl()
+}
 
-	g.initFiles(packageName, svr)
+func (g *tsGen) Gen(applicationName string, packageName string, svrs []*data.ServiceData, messages []*data.MessageData, enums []*data.EnumData, options data.OptionMap) (map[string]string, error) {
+	g.initFiles(applicationName, packageName, svrs)
 	for _, msg := range messages {
-		data.FlattenLocalPackage(msg)
+		g.req.FlattenLocalPackage(msg)
 	}
 
 	g.DataTypes = messages
+	for _, svr := range svrs {
+		for _, m := range svr.Methods {
+			g.HasStreaming = g.HasStreaming || m.ServerStreaming
+		}
+	}
 
 	/**
 	* Map Data: messages and service
 	 */
 	dataMap := tsStruct{
-		ClassName: svr.Name,
+		ClassName: g.service.Name,
+		ObjsName:  g.objsName,
 		DataTypes: messages,
 		Enums:     enums,
-		Functions: svr.Methods,
+		Functions: g.service.Methods,
 		Gen:       g,
 	}
 
 	var result = make(map[string]string)
-	switch g.Lib {
-	case tsLibAxios:
-		result[g.axiosFile] = g.genContent(g.axiosTpl, dataMap)
-	default:
-		result[g.fetchFile] = g.genContent(g.fetchTpl, dataMap)
+	// one api file per service
+	for _, svr := range svrs {
+		svrMap := dataMap
+		svrMap.ClassName = svr.Name
+		svrMap.Functions = svr.Methods
+		svrMap.Deprecated = svr.Deprecated
+		if commonError, ok := svr.Options["common_error"]; ok && commonError != g.CommonError() {
+			svrMap.CommonErrorMapper = commonErrorMapper(commonError)
+		}
+
+		var tpl *template.Template
+		switch g.Lib {
+		case tsLibAxios:
+			tpl = g.axiosTpl
+		default:
+			tpl = g.fetchTpl
+		}
+		content, err := g.genContent(tpl, svrMap)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", svr.Name, err)
+		}
+		result[genFileName(packageName, svr.Name)] = content
 	}
 
-	result[g.objsFile] = g.genContent(g.objsTpl, dataMap)
-	result[g.helperFile] = g.genContent(g.helperTpl, dataMap)
+	var err error
+	if result[g.objsFile], err = g.genContent(g.objsTpl, dataMap); err != nil {
+		return nil, err
+	}
+	if result[g.helperFile], err = g.genContent(g.helperTpl, dataMap); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -244,8 +398,8 @@ func getTSgen(lib tsLibs) *tsGen {
 }
 
 func init() {
-	fetch := getTSgen(tsLibFetch)
-	axios := getTSgen(tsLibAxios)
+	fetch := func() data.CodeGenerator { return getTSgen(tsLibFetch) }
+	axios := func() data.CodeGenerator { return getTSgen(tsLibAxios) }
 	data.OutputMap["ts"] = axios
 	data.OutputMap["ts-fetch"] = fetch
 	data.OutputMap["ts-axios"] = axios
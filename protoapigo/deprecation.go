@@ -0,0 +1,15 @@
+package protoapigo
+
+import (
+	"github.com/labstack/echo"
+)
+
+// SetDeprecationHeaders marks the response of a deprecated method with the Deprecation header,
+// sunset is the HTTP-date of the Sunset header, none is sent if it is empty
+func SetDeprecationHeaders(c echo.Context, sunset string) {
+	header := c.Response().Header()
+	header.Set("Deprecation", "true")
+	if sunset != "" {
+		header.Set("Sunset", sunset)
+	}
+}
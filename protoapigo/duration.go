@@ -0,0 +1,145 @@
+package protoapigo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// FormatDuration formats a duration the way proto3 JSON encodes google.protobuf.Duration,
+// ie seconds with 0, 3, 6 or 9 fractional digits followed by "s", like "1.500s"
+func FormatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	seconds := int64(d / time.Second)
+	nanos := int64(d % time.Second)
+	switch {
+	case nanos == 0:
+		return fmt.Sprintf("%s%ds", sign, seconds)
+	case nanos%1e6 == 0:
+		return fmt.Sprintf("%s%d.%03ds", sign, seconds, nanos/1e6)
+	case nanos%1e3 == 0:
+		return fmt.Sprintf("%s%d.%06ds", sign, seconds, nanos/1e3)
+	default:
+		return fmt.Sprintf("%s%d.%09ds", sign, seconds, nanos)
+	}
+}
+
+// ParseDuration parses a proto3 JSON duration like "1.5s"
+func ParseDuration(s string) (time.Duration, error) {
+	if !strings.HasSuffix(s, "s") || strings.ContainsAny(s[:len(s)-1], "hmsuµn") {
+		return 0, fmt.Errorf("invalid duration %q, expected seconds like \"1.5s\"", s)
+	}
+	return time.ParseDuration(s)
+}
+
+// MarshalDuration returns the proto3 JSON encoding of a time.Duration,
+// or of a pointer, slice or map of time.Duration
+func MarshalDuration(v interface{}) ([]byte, error) {
+	return json.Marshal(durationToJSON(reflect.ValueOf(v)))
+}
+
+// UnmarshalDuration parses proto3 JSON durations into v, which is a pointer to a time.Duration,
+// or to a pointer, slice or map of time.Duration
+func UnmarshalDuration(data []byte, v interface{}) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return durationFromJSON(value, reflect.ValueOf(v).Elem())
+}
+
+func durationToJSON(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return durationToJSON(v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		result := make([]interface{}, v.Len())
+		for i := range result {
+			result[i] = durationToJSON(v.Index(i))
+		}
+		return result
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		result := make(map[string]interface{}, v.Len())
+		for _, key := range v.MapKeys() {
+			result[fmt.Sprint(key.Interface())] = durationToJSON(v.MapIndex(key))
+		}
+		return result
+	default:
+		return FormatDuration(time.Duration(v.Int()))
+	}
+}
+
+func durationFromJSON(value interface{}, v reflect.Value) error {
+	if value == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		result := reflect.New(v.Type().Elem())
+		if err := durationFromJSON(value, result.Elem()); err != nil {
+			return err
+		}
+		v.Set(result)
+	case reflect.Slice:
+		list, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("invalid durations %v, expected an array", value)
+		}
+		result := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i, item := range list {
+			if err := durationFromJSON(item, result.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(result)
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid durations %v, expected an object", value)
+		}
+		result := reflect.MakeMapWithSize(v.Type(), len(object))
+		for k, item := range object {
+			key := reflect.New(v.Type().Key())
+			if v.Type().Key().Kind() == reflect.String {
+				key.Elem().SetString(k)
+			} else if err := json.Unmarshal([]byte(k), key.Interface()); err != nil {
+				return err
+			}
+			elem := reflect.New(v.Type().Elem())
+			if err := durationFromJSON(item, elem.Elem()); err != nil {
+				return err
+			}
+			result.SetMapIndex(key.Elem(), elem.Elem())
+		}
+		v.Set(result)
+	default:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("invalid duration %v, expected a string like \"1.5s\"", value)
+		}
+		d, err := ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package protoapigo
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/labstack/echo"
@@ -14,11 +15,21 @@ type JSONAPIBinder struct {
 }
 
 // Bind use json decoder for all context type & DisallowUnknownFields
+// The GET, HEAD and DELETE requests carry no body, their input is bound from the query parameters
 func (b *JSONAPIBinder) Bind(i interface{}, c echo.Context) (err error) {
 	req := c.Request()
-	d := json.NewDecoder(req.Body)
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return BindQueryParams(i, req.URL.Query())
+	}
+	return decodeJSON(req.Body, i)
+}
+
+// decodeJSON decodes the JSON input into i, the errors are returned as bad requests
+func decodeJSON(r io.Reader, i interface{}) error {
+	d := json.NewDecoder(r)
 	d.DisallowUnknownFields()
-	if err = d.Decode(i); err != nil {
+	if err := d.Decode(i); err != nil {
 		if ute, ok := err.(*json.UnmarshalTypeError); ok {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unmarshal type error: expected=%v, got=%v, offset=%v", ute.Type, ute.Value, ute.Offset))
 		} else if se, ok := err.(*json.SyntaxError); ok {
@@ -27,5 +38,5 @@ func (b *JSONAPIBinder) Bind(i interface{}, c echo.Context) (err error) {
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 	}
-	return
+	return nil
 }
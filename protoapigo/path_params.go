@@ -0,0 +1,57 @@
+package protoapigo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
+)
+
+// BindPathParams sets the request fields bound from the path parameters of the route,
+// fields holds pointers to the fields by parameter name. The methods served with several
+// routes pass the fields of all of them, only the parameters of the matched route are bound.
+func BindPathParams(c echo.Context, fields map[string]interface{}) error {
+	for _, name := range c.ParamNames() {
+		field, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if err := parsePathParam(c.Param(name), field); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid path parameter %s: %v", name, err))
+		}
+	}
+	return nil
+}
+
+// parsePathParam parses the value into the field pointer, enums are given by number
+func parsePathParam(value string, field interface{}) (err error) {
+	switch f := field.(type) {
+	case *string:
+		*f = value
+	case *bool:
+		*f, err = strconv.ParseBool(value)
+	case *int32:
+		var v int64
+		v, err = strconv.ParseInt(value, 10, 32)
+		*f = int32(v)
+	case *int64:
+		*f, err = strconv.ParseInt(value, 10, 64)
+	case *uint32:
+		var v uint64
+		v, err = strconv.ParseUint(value, 10, 32)
+		*f = uint32(v)
+	case *uint64:
+		*f, err = strconv.ParseUint(value, 10, 64)
+	case *float32:
+		var v float64
+		v, err = strconv.ParseFloat(value, 32)
+		*f = float32(v)
+	case *float64:
+		*f, err = strconv.ParseFloat(value, 64)
+	default:
+		err = json.Unmarshal([]byte(value), field)
+	}
+	return err
+}
@@ -0,0 +1,103 @@
+package protoapigo
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// jsonFields is implemented by the messages with durations or oneof groups, it returns
+// a nil pointer of the type of each field tagged "-" that MarshalJSON writes, by JSON key
+type jsonFields interface {
+	XXX_JSONFields() map[string]interface{}
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// BindQueryParams sets the request fields from the query parameters, keyed by the JSON keys of the fields.
+// The scalars are given as plain values, the lists as repeated parameters ("key" or "key[]") or as
+// a JSON array, and the messages and maps in JSON, as sent by the generated clients.
+// The parameters that are not fields, like cache busters or tracking parameters, are ignored.
+func BindQueryParams(i interface{}, query url.Values) error {
+	if len(query) == 0 {
+		return nil
+	}
+
+	types := jsonFieldTypes(reflect.TypeOf(i))
+	fields := make(map[string]json.RawMessage, len(query))
+	for key, values := range query {
+		key = strings.TrimSuffix(key, "[]")
+		t, ok := types[key]
+		if !ok {
+			continue
+		}
+		if t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+			if len(values) == 1 && strings.HasPrefix(values[0], "[") && json.Valid([]byte(values[0])) {
+				fields[key] = json.RawMessage(values[0])
+				continue
+			}
+			// the list parameters are merged whether they are given with the [] suffix or not
+			var list []json.RawMessage
+			if fields[key] != nil {
+				json.Unmarshal(fields[key], &list)
+			}
+			for _, value := range values {
+				list = append(list, queryValue(value, t.Elem()))
+			}
+			fields[key], _ = json.Marshal(list)
+			continue
+		}
+		fields[key] = queryValue(values[len(values)-1], t)
+	}
+
+	jsonStr, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return decodeJSON(bytes.NewReader(jsonStr), i)
+}
+
+// queryValue returns the JSON value of the query parameter for the field type,
+// the strings, the durations and the values that are not valid JSON are quoted
+func queryValue(value string, t reflect.Type) json.RawMessage {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.String && t != durationType && json.Valid([]byte(value)) {
+		return json.RawMessage(value)
+	}
+	quoted, _ := json.Marshal(value)
+	return quoted
+}
+
+// jsonFieldTypes returns the types of the struct fields by JSON key,
+// the durations and the oneof members included
+func jsonFieldTypes(t reflect.Type) map[string]reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	types := make(map[string]reflect.Type)
+	if t.Kind() != reflect.Struct {
+		return types
+	}
+	if holder, ok := reflect.New(t).Interface().(jsonFields); ok {
+		for name, field := range holder.XXX_JSONFields() {
+			types[name] = reflect.TypeOf(field).Elem()
+		}
+	}
+	for n := 0; n < t.NumField(); n++ {
+		field := t.Field(n)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" || field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		types[name] = field.Type
+	}
+	return types
+}
@@ -0,0 +1,87 @@
+package protoapigo
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo"
+)
+
+// SSEStream sends the responses of a server-streaming method as Server-Sent Events,
+// every response is a data event holding its JSON
+type SSEStream struct {
+	c       echo.Context
+	started bool
+}
+
+// NewSSEStream returns the stream of the responses to the request of c
+func NewSSEStream(c echo.Context) *SSEStream {
+	return &SSEStream{c: c}
+}
+
+// Started returns if the event stream has begun, the errors are sent as error events from then on
+func (s *SSEStream) Started() bool {
+	return s.started
+}
+
+// Send writes a response as a data event and flushes it to the client
+func (s *SSEStream) Send(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.event("", b)
+}
+
+// Error sends an error with the status code of the unary methods. It is the response if the stream has not
+// begun, strings are sent as text and the other values as JSON. Otherwise it is an error event holding
+// {"status": status, "error": v}.
+func (s *SSEStream) Error(status int, v interface{}) error {
+	if !s.started {
+		if text, ok := v.(string); ok {
+			return s.c.String(status, text)
+		}
+		return s.c.JSON(status, v)
+	}
+	b, err := json.Marshal(map[string]interface{}{"status": status, "error": v})
+	if err != nil {
+		return err
+	}
+	return s.event("error", b)
+}
+
+// Close ends the stream, it begins it first if nothing was sent so the client gets an empty stream
+func (s *SSEStream) Close() error {
+	s.start()
+	return nil
+}
+
+func (s *SSEStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	header := s.c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	s.c.Response().WriteHeader(http.StatusOK)
+	s.c.Response().Flush()
+}
+
+func (s *SSEStream) event(name string, data []byte) error {
+	s.start()
+	var buf bytes.Buffer
+	if name != "" {
+		buf.WriteString("event: " + name + "\n")
+	}
+	// the JSON encoding has no line breaks, the data fits in one line
+	buf.WriteString("data: ")
+	buf.Write(data)
+	buf.WriteString("\n\n")
+	if _, err := s.c.Response().Write(buf.Bytes()); err != nil {
+		return err
+	}
+	s.c.Response().Flush()
+	return nil
+}
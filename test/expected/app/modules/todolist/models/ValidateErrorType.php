@@ -5,4 +5,9 @@ class ValidateErrorType extends Enum
 {
     const INVALID_EMAIL = 0;
     const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
 }
@@ -0,0 +1,71 @@
+// Code generated by protoapi; DO NOT EDIT.
+
+package com.yoozoo.reserved;
+
+import com.fasterxml.jackson.annotation.JsonCreator;
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+import java.util.List;
+
+public class Item {
+    private final String type;
+    private final String class_;
+    private final boolean default_;
+    private final String package_;
+    private final int func;
+    private final int new_;
+    private final List<String> var;
+    private final Kind kind;
+    private final String function;
+    private final Status status;
+
+    @JsonCreator
+    public Item(@JsonProperty("type") String type, @JsonProperty("class") String class_, @JsonProperty("default") boolean default_, @JsonProperty("package") String package_, @JsonProperty("func") int func, @JsonProperty("new") int new_, @JsonProperty("var") List<String> var, @JsonProperty("kind") Kind kind, @JsonProperty("function") String function, @JsonProperty("status") Status status) {
+        this.type = type;
+        this.class_ = class_;
+        this.default_ = default_;
+        this.package_ = package_;
+        this.func = func;
+        this.new_ = new_;
+        this.var = var;
+        this.kind = kind;
+        this.function = function;
+        this.status = status;
+    }
+
+    public String getType() {
+        return type;
+    }
+    @JsonProperty("class")
+    public String getClass_() {
+        return class_;
+    }
+    @JsonProperty("default")
+    public boolean getDefault() {
+        return default_;
+    }
+    @JsonProperty("package")
+    public String getPackage() {
+        return package_;
+    }
+    public int getFunc() {
+        return func;
+    }
+    @JsonProperty("new")
+    public int getNew() {
+        return new_;
+    }
+    public List<String> getVar() {
+        return var;
+    }
+    public Kind getKind() {
+        return kind;
+    }
+    public String getFunction() {
+        return function;
+    }
+    public Status getStatus() {
+        return status;
+    }
+    
+}
@@ -7,109 +7,140 @@ import org.springframework.web.bind.annotation.PostMapping;
 import org.springframework.web.bind.annotation.ResponseBody;
 import org.springframework.web.bind.annotation.RequestBody;
 
+/**
+ * This service contains all the rpc related with services
+ * option (service_method) can be "POST" or "GET", if not specified, both post
+ * and get methods will be supported
+ */
 public abstract class AppServiceBase {
+    /** get env */
     @PostMapping("/AppService.getEnv")
     @ResponseBody
     public EnvListResponse getEnvPost(@RequestBody EnvListRequest in) {
         return getEnv(in);
     }
 
+    /** get env */
     abstract EnvListResponse getEnv(EnvListRequest in);
     
+    /** register a service */
     @PostMapping("/AppService.registerService")
     @ResponseBody
     public RegisterServiceResponse registerServicePost(@RequestBody RegisterServiceRequest in) {
         return registerService(in);
     }
 
+    /** register a service */
     abstract RegisterServiceResponse registerService(RegisterServiceRequest in);
     
+    /** update a service */
     @PostMapping("/AppService.updateService")
     @ResponseBody
     public UpdateServiceResponse updateServicePost(@RequestBody UpdateServiceRequest in) {
         return updateService(in);
     }
 
+    /** update a service */
     abstract UpdateServiceResponse updateService(UpdateServiceRequest in);
     
+    /** upload proto file */
     @PostMapping("/AppService.uploadProtoFile")
     @ResponseBody
     public UploadProtoFileResponse uploadProtoFilePost(@RequestBody UploadProtoFileRequest in) {
         return uploadProtoFile(in);
     }
 
+    /** upload proto file */
     abstract UploadProtoFileResponse uploadProtoFile(UploadProtoFileRequest in);
     
+    /** get a list of tags that contains apps */
     @PostMapping("/AppService.getTags")
     @ResponseBody
     public TagListResponse getTagsPost(@RequestBody TagListRequest in) {
         return getTags(in);
     }
 
+    /** get a list of tags that contains apps */
     abstract TagListResponse getTags(TagListRequest in);
     
+    /** get a list of apps under specified tag */
     @PostMapping("/AppService.getProducts")
     @ResponseBody
     public ProductListResponse getProductsPost(@RequestBody ProductListRequest in) {
         return getProducts(in);
     }
 
+    /** get a list of apps under specified tag */
     abstract ProductListResponse getProducts(ProductListRequest in);
     
+    /** get a list of services under specified tag */
     @PostMapping("/AppService.getServices")
     @ResponseBody
     public ServiceListResponse getServicesPost(@RequestBody ServiceListRequest in) {
         return getServices(in);
     }
 
+    /** get a list of services under specified tag */
     abstract ServiceListResponse getServices(ServiceListRequest in);
     
+    /** search services */
     @PostMapping("/AppService.searchServices")
     @ResponseBody
     public ServiceListResponse searchServicesPost(@RequestBody ServiceSearchRequest in) {
         return searchServices(in);
     }
 
+    /** search services */
     abstract ServiceListResponse searchServices(ServiceSearchRequest in);
     
+    /** get a list of keys of the specified service */
     @PostMapping("/AppService.getKeyList")
     @ResponseBody
     public KeyListResponse getKeyListPost(@RequestBody KeyListRequest in) {
         return getKeyList(in);
     }
 
+    /** get a list of keys of the specified service */
     abstract KeyListResponse getKeyList(KeyListRequest in);
     
+    /** get key-value pairs given the list of keys */
     @PostMapping("/AppService.getKeyValueList")
     @ResponseBody
     public KeyValueListResponse getKeyValueListPost(@RequestBody KeyValueListRequest in) {
         return getKeyValueList(in);
     }
 
+    /** get key-value pairs given the list of keys */
     abstract KeyValueListResponse getKeyValueList(KeyValueListRequest in);
     
+    /** search key value list by key or value */
     @PostMapping("/AppService.searchKeyValueList")
     @ResponseBody
     public KeyValueListResponse searchKeyValueListPost(@RequestBody SearchKeyValueListRequest in) {
         return searchKeyValueList(in);
     }
 
+    /** search key value list by key or value */
     abstract KeyValueListResponse searchKeyValueList(SearchKeyValueListRequest in);
     
+    /** update value by key */
     @PostMapping("/AppService.updateKeyValue")
     @ResponseBody
     public KeyValueResponse updateKeyValuePost(@RequestBody KeyValueRequest in) {
         return updateKeyValue(in);
     }
 
+    /** update value by key */
     abstract KeyValueResponse updateKeyValue(KeyValueRequest in);
     
+    /** fetch key's value change history */
     @PostMapping("/AppService.fetchKeyHistory")
     @ResponseBody
     public KVHistoryResponse fetchKeyHistoryPost(@RequestBody KVHistoryRequest in) {
         return fetchKeyHistory(in);
     }
 
+    /** fetch key's value change history */
     abstract KVHistoryResponse fetchKeyHistory(KVHistoryRequest in);
     
 }
@@ -5,8 +5,6 @@ package com.yoozoo.spring;
 import com.fasterxml.jackson.annotation.JsonCreator;
 import com.fasterxml.jackson.annotation.JsonProperty;
 
-import java.util.List;
-
 public class BindError {
     private final String message;
 
@@ -5,8 +5,6 @@ package com.yoozoo.spring;
 import com.fasterxml.jackson.annotation.JsonCreator;
 import com.fasterxml.jackson.annotation.JsonProperty;
 
-import java.util.List;
-
 public class CommonError {
     private final GenericError genericError;
     private final AuthError authError;
@@ -5,8 +5,7 @@ package com.yoozoo.spring;
 import com.fasterxml.jackson.annotation.JsonCreator;
 import com.fasterxml.jackson.annotation.JsonProperty;
 
-import java.util.List;
-
+/** other building blocks */
 public class Env {
     private final int env_id;
     private final String env_name;
@@ -5,8 +5,7 @@ package com.yoozoo.spring;
 import com.fasterxml.jackson.annotation.JsonCreator;
 import com.fasterxml.jackson.annotation.JsonProperty;
 
-import java.util.List;
-
+/** env list - 环境 */
 public class EnvListRequest {
 
     @JsonCreator
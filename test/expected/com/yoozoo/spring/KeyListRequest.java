@@ -5,8 +5,11 @@ package com.yoozoo.spring;
 import com.fasterxml.jackson.annotation.JsonCreator;
 import com.fasterxml.jackson.annotation.JsonProperty;
 
-import java.util.List;
-
+/**
+ * key list
+ * PS: separate key list & key value because proto and kv store may be on
+ * different endpoint
+ */
 public class KeyListRequest {
     private final int service_id;
     private final int env_id;
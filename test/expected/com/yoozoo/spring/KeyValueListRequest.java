@@ -7,6 +7,7 @@ import com.fasterxml.jackson.annotation.JsonProperty;
 
 import java.util.List;
 
+/** key value */
 public class KeyValueListRequest {
     private final int service_id;
     private final List<Key> keys;
@@ -5,8 +5,7 @@ package com.yoozoo.spring;
 import com.fasterxml.jackson.annotation.JsonCreator;
 import com.fasterxml.jackson.annotation.JsonProperty;
 
-import java.util.List;
-
+/** product list */
 public class ProductListRequest {
     private final int env_id;
 
@@ -15,6 +14,7 @@ public class ProductListRequest {
         this.env_id = env_id;
     }
 
+    /** DEV, UAT, PROD etc */
     public int getEnv_id() {
         return env_id;
     }
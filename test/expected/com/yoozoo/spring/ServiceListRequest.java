@@ -7,6 +7,7 @@ import com.fasterxml.jackson.annotation.JsonProperty;
 
 import java.util.List;
 
+/** service list */
 public class ServiceListRequest {
     private final List<Integer> tag_ids;
     private final int env_id;
@@ -21,6 +22,7 @@ public class ServiceListRequest {
         this.limit = limit;
     }
 
+    /** optional, for filter */
     public List<Integer> getTag_ids() {
         return tag_ids;
     }
@@ -7,6 +7,7 @@ import com.fasterxml.jackson.annotation.JsonProperty;
 
 import java.util.List;
 
+/** service list */
 public class ServiceSearchRequest {
     private final List<Integer> tag_ids;
     private final String prefix;
@@ -23,6 +24,10 @@ public class ServiceSearchRequest {
         this.limit = limit;
     }
 
+    /**
+     * optional, for filter
+     * string prefix = 2 [ (val_required) = true, (val_format) = "email" ];
+     */
     public List<Integer> getTag_ids() {
         return tag_ids;
     }
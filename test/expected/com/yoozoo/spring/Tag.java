@@ -5,8 +5,7 @@ package com.yoozoo.spring;
 import com.fasterxml.jackson.annotation.JsonCreator;
 import com.fasterxml.jackson.annotation.JsonProperty;
 
-import java.util.List;
-
+/** 用于分类 */
 public class Tag {
     private final int tag_id;
     private final String tag_name;
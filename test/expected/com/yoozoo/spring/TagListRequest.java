@@ -5,8 +5,7 @@ package com.yoozoo.spring;
 import com.fasterxml.jackson.annotation.JsonCreator;
 import com.fasterxml.jackson.annotation.JsonProperty;
 
-import java.util.List;
-
+/** tag list - 用于分类 */
 public class TagListRequest {
 
     @JsonCreator
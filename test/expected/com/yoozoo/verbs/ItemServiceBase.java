@@ -0,0 +1,82 @@
+// Code generated by protoapi; DO NOT EDIT.
+
+package com.yoozoo.verbs;
+
+import org.springframework.web.bind.annotation.GetMapping;
+import org.springframework.web.bind.annotation.PostMapping;
+import org.springframework.web.bind.annotation.RequestMapping;
+import org.springframework.web.bind.annotation.RequestMethod;
+import org.springframework.web.bind.annotation.PutMapping;
+import org.springframework.web.bind.annotation.PatchMapping;
+import org.springframework.web.bind.annotation.DeleteMapping;
+import org.springframework.web.bind.annotation.ResponseBody;
+import org.springframework.web.bind.annotation.RequestBody;
+
+public abstract class ItemServiceBase {
+    /** served with POST by default */
+    @PostMapping("/ItemService.create")
+    @ResponseBody
+    public Item createPost(@RequestBody Item in) {
+        return create(in);
+    }
+
+    /** served with POST by default */
+    abstract Item create(Item in);
+    
+    @GetMapping("/ItemService.find")
+    @ResponseBody
+    public Item findGet(ItemRequest in) {
+        return find(in);
+    }
+
+    abstract Item find(ItemRequest in);
+    
+    @RequestMapping(value = "/ItemService.exists", method = RequestMethod.HEAD)
+    @ResponseBody
+    public Item existsHead(ItemRequest in) {
+        return exists(in);
+    }
+
+    abstract Item exists(ItemRequest in);
+    
+    @PutMapping("/ItemService.update")
+    @ResponseBody
+    public Item updatePut(@RequestBody Item in) {
+        return update(in);
+    }
+
+    abstract Item update(Item in);
+    
+    @PatchMapping("/ItemService.rename")
+    @ResponseBody
+    public Item renamePatch(@RequestBody Item in) {
+        return rename(in);
+    }
+
+    abstract Item rename(Item in);
+    
+    @DeleteMapping("/ItemService.remove")
+    @ResponseBody
+    public Item removeDelete(ItemRequest in) {
+        return remove(in);
+    }
+
+    abstract Item remove(ItemRequest in);
+    
+    /** the first verb is used by the clients */
+    @GetMapping("/ItemService.search")
+    @ResponseBody
+    public Item searchGet(ItemRequest in) {
+        return search(in);
+    }
+    /** the first verb is used by the clients */
+    @PostMapping("/ItemService.search")
+    @ResponseBody
+    public Item searchPost(@RequestBody ItemRequest in) {
+        return search(in);
+    }
+
+    /** the first verb is used by the clients */
+    abstract Item search(ItemRequest in);
+    
+}
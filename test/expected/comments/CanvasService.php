@@ -0,0 +1,590 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace comments;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+/**
+ * Figure is a shape drawn on the canvas.
+ *
+ * The position is the top left corner.
+ */
+class Figure implements ProtoApi\Message
+{
+    /** identifier of the figure */
+    protected $id;
+    /** kind of the figure */
+    protected $shape;
+    /**
+     * position, in pixels:
+     *   x from the left
+     *   y from the top
+     */
+    protected $position;
+    protected $style;
+    /** fill color, like #ff0000 */
+    protected $color;
+    /** no fill */
+    protected $empty;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+        if (isset($response["shape"])) {
+            $this->shape = $response["shape"];
+        }
+        if (isset($response["position"])) {
+            $this->position = new Point();
+            $this->position->init($response["position"]);
+            $this->position->validate();
+        }
+        if (isset($response["style"])) {
+            $this->style = $response["style"];
+        }
+        if (isset($response["color"])) {
+            $this->color = $response["color"];
+        }
+        if (isset($response["empty"])) {
+            $this->empty = $response["empty"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+        if (!isset($this->shape)) {
+            throw new ProtoApi\GeneralException("'shape' is not exist");
+        }
+        if (!isset($this->position)) {
+            throw new ProtoApi\GeneralException("'position' is not exist");
+        }
+        if (!isset($this->style)) {
+            throw new ProtoApi\GeneralException("'style' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function set_shape($shape)
+    {
+        $this->shape = $shape;
+    }
+
+    public function get_shape()
+    {
+        return $this->shape;
+    }
+    
+    public function set_position(Position $position)
+    {
+        $this->position = $position;
+    }
+
+    public function get_position()
+    {
+        return $this->position;
+    }
+    
+    public function set_style($style)
+    {
+        $this->style = $style;
+    }
+
+    public function get_style()
+    {
+        return $this->style;
+    }
+    
+    public function set_color($color)
+    {
+        $this->color = $color;
+    }
+
+    public function get_color()
+    {
+        return $this->color;
+    }
+    
+    public function set_empty($empty)
+    {
+        $this->empty = $empty;
+    }
+
+    public function get_empty()
+    {
+        return $this->empty;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+            "shape" => $this->shape,
+            "position" => $this->position->to_array(),
+            "style" => $this->style,
+            "color" => $this->color,
+            "empty" => $this->empty,
+        );
+    }
+}
+
+/** Point is a position on the canvas */
+class Point implements ProtoApi\Message
+{
+    protected $x;
+    protected $y;
+
+    public function init(array $response)
+    {
+        if (isset($response["x"])) {
+            $this->x = $response["x"];
+        }
+        if (isset($response["y"])) {
+            $this->y = $response["y"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->x)) {
+            throw new ProtoApi\GeneralException("'x' is not exist");
+        }
+        if (!isset($this->y)) {
+            throw new ProtoApi\GeneralException("'y' is not exist");
+        }
+    }
+    
+    public function set_x($x)
+    {
+        $this->x = $x;
+    }
+
+    public function get_x()
+    {
+        return $this->x;
+    }
+    
+    public function set_y($y)
+    {
+        $this->y = $y;
+    }
+
+    public function get_y()
+    {
+        return $this->y;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "x" => $this->x,
+            "y" => $this->y,
+        );
+    }
+}
+
+class FigureRequest implements ProtoApi\Message
+{
+    protected $id;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+/**
+ * Shape is the kind of a figure.
+ * Unknown shapes are sent as 0.
+ */
+class Shape extends Enum
+{
+    /** not set */
+    const UNKNOWN = 0;
+    /** four equal sides */
+    const SQUARE = 1;
+    const CIRCLE = 2;
+}
+
+/** Style of the stroke, nested in the figure */
+class Style extends Enum
+{
+    const SOLID = 0;
+    /** drawn with dashes */
+    const DASHED = 1;
+}
+
+/** CanvasService draws figures. */
+class CanvasService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    /**
+     * draw adds a figure to the canvas,
+     * it replaces the figure of the same id
+     */
+    public function draw(Figure $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Figure();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "CanvasService.draw", $handler);
+    }
+
+    /** finds a figure by id */
+    public function find(FigureRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Figure();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "CanvasService.find", $handler);
+    }
+}
@@ -0,0 +1,50 @@
+/**
+* This file is generated by 'protoapi'
+* The file contains frontend API code that work with fetch API for HTTP usages
+* The generated code is written in TypeScript
+* The code provides a basic usage for API call and may need adjustment according to specific project requirement and situation
+* -------------------------------------------
+* 该文件生成于protoapi
+* 文件包含前端调用API的代码，并使用fetch做HTTP调用
+* 文件内代码使用TypeScript
+* 该生成文件只提供前端API调用基本代码，实际情况可能需要根据具体项目具体要求不同而作出更改
+*/
+import {
+    Figure,
+    FigureRequest,
+    
+} from './CanvasServiceObjs';
+import { generateQueryUrl, errorHandling } from './helper';
+
+var baseUrl = "http://192.168.115.60:8080";
+
+export function SetBaseUrl(url: string) {
+    baseUrl = url;
+}// use fetch
+// GET, HEAD and DELETE requests send the params in the query string, the others in the JSON body
+function call<InType, OutType>(url: string, params: InType, httpMethod: string): Promise<OutType | never> {
+    let init: RequestInit = { method: httpMethod };
+    if (httpMethod === 'GET' || httpMethod === 'HEAD' || httpMethod === 'DELETE') {
+        url = generateQueryUrl(url, params);
+    } else {
+        init.body = JSON.stringify(params);
+    }
+
+    return fetch(url, init).then(res => {
+        return Promise.resolve(res.json())
+    }).catch(err => {
+        return errorHandling(err)
+    });
+}
+/**
+ * draw adds a figure to the canvas,
+ * it replaces the figure of the same id
+ */
+export function draw(params: Figure): Promise<Figure | never> {
+    return call<Figure, Figure>(baseUrl + "/CanvasService.draw", params, "POST");
+}
+
+/** finds a figure by id */
+export function find(params: FigureRequest): Promise<Figure | never> {
+    return call<FigureRequest, Figure>(baseUrl + "/CanvasService.find", params, "POST");
+}
@@ -0,0 +1,102 @@
+/**
+* This file is generated by 'protoapi'
+* This file contains all the data structure being used in the generated ts services
+* -----------------------------------------------------
+* 该文件生成于protoapi
+* 文件包含API前端调用所引用的数据结构定义
+*/
+
+// enums
+export enum ValidateErrorType {
+    INVALID_EMAIL = 0,
+    FIELD_REQUIRED = 1,
+    OUT_OF_RANGE = 2,
+    INVALID_LENGTH = 3,
+    PATTERN_MISMATCH = 4,
+    INVALID_ITEM_COUNT = 5,
+    UNDEFINED_ENUM_VALUE = 6,
+}
+
+/**
+ * Shape is the kind of a figure.
+ * Unknown shapes are sent as 0.
+ */
+export enum Shape {
+    /** not set */
+    UNKNOWN = 0,
+    /** four equal sides */
+    SQUARE = 1,
+    CIRCLE = 2,
+}
+
+/** Style of the stroke, nested in the figure */
+export enum Style {
+    SOLID = 0,
+    /** drawn with dashes */
+    DASHED = 1,
+}
+
+// data types
+export interface CommonError {
+    genericError: GenericError
+    authError: AuthError
+    validateError: ValidateError
+    bindError: BindError
+}
+
+export interface GenericError {
+    message: string
+}
+
+export interface AuthError {
+    message: string
+}
+
+export interface BindError {
+    message: string
+}
+
+export interface ValidateError {
+    errors: FieldError[]
+}
+
+export interface FieldError {
+    fieldName: string
+    errorType: ValidateErrorType
+}
+
+export interface Empty {
+}
+
+/**
+ * Figure is a shape drawn on the canvas.
+ *
+ * The position is the top left corner.
+ */
+export type Figure = {
+    /** identifier of the figure */
+    id: number
+    /** kind of the figure */
+    shape: Shape
+    /**
+     * position, in pixels:
+     *   x from the left
+     *   y from the top
+     */
+    position: Point
+    style: Style
+} & (
+    | { color: string; empty?: never; }
+    | { color?: never; empty: boolean; }
+    | { color?: never; empty?: never; }
+)
+
+/** Point is a position on the canvas */
+export interface Point {
+    x: number
+    y: number
+}
+
+export interface FigureRequest {
+    id: number
+}
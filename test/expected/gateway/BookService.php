@@ -0,0 +1,676 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace gateway;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+class Book implements ProtoApi\Message
+{
+    protected $shelf_id;
+    protected $book_id;
+    protected $title;
+
+    public function init(array $response)
+    {
+        if (isset($response["shelf_id"])) {
+            $this->shelf_id = $response["shelf_id"];
+        }
+        if (isset($response["book_id"])) {
+            $this->book_id = $response["book_id"];
+        }
+        if (isset($response["title"])) {
+            $this->title = $response["title"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->shelf_id)) {
+            throw new ProtoApi\GeneralException("'shelf_id' is not exist");
+        }
+        if (!isset($this->book_id)) {
+            throw new ProtoApi\GeneralException("'book_id' is not exist");
+        }
+        if (!isset($this->title)) {
+            throw new ProtoApi\GeneralException("'title' is not exist");
+        }
+    }
+    
+    public function set_shelf_id($shelf_id)
+    {
+        $this->shelf_id = $shelf_id;
+    }
+
+    public function get_shelf_id()
+    {
+        return $this->shelf_id;
+    }
+    
+    public function set_book_id($book_id)
+    {
+        $this->book_id = $book_id;
+    }
+
+    public function get_book_id()
+    {
+        return $this->book_id;
+    }
+    
+    public function set_title($title)
+    {
+        $this->title = $title;
+    }
+
+    public function get_title()
+    {
+        return $this->title;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "shelf_id" => $this->shelf_id,
+            "book_id" => $this->book_id,
+            "title" => $this->title,
+        );
+    }
+}
+
+class BookRequest implements ProtoApi\Message
+{
+    protected $shelf_id;
+    protected $book_id;
+
+    public function init(array $response)
+    {
+        if (isset($response["shelf_id"])) {
+            $this->shelf_id = $response["shelf_id"];
+        }
+        if (isset($response["book_id"])) {
+            $this->book_id = $response["book_id"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->shelf_id)) {
+            throw new ProtoApi\GeneralException("'shelf_id' is not exist");
+        }
+        if (!isset($this->book_id)) {
+            throw new ProtoApi\GeneralException("'book_id' is not exist");
+        }
+    }
+    
+    public function set_shelf_id($shelf_id)
+    {
+        $this->shelf_id = $shelf_id;
+    }
+
+    public function get_shelf_id()
+    {
+        return $this->shelf_id;
+    }
+    
+    public function set_book_id($book_id)
+    {
+        $this->book_id = $book_id;
+    }
+
+    public function get_book_id()
+    {
+        return $this->book_id;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "shelf_id" => $this->shelf_id,
+            "book_id" => $this->book_id,
+        );
+    }
+}
+
+class MoveBookRequest implements ProtoApi\Message
+{
+    protected $shelf_id;
+    protected $book;
+
+    public function init(array $response)
+    {
+        if (isset($response["shelf_id"])) {
+            $this->shelf_id = $response["shelf_id"];
+        }
+        if (isset($response["book"])) {
+            $this->book = new Book();
+            $this->book->init($response["book"]);
+            $this->book->validate();
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->shelf_id)) {
+            throw new ProtoApi\GeneralException("'shelf_id' is not exist");
+        }
+        if (!isset($this->book)) {
+            throw new ProtoApi\GeneralException("'book' is not exist");
+        }
+    }
+    
+    public function set_shelf_id($shelf_id)
+    {
+        $this->shelf_id = $shelf_id;
+    }
+
+    public function get_shelf_id()
+    {
+        return $this->shelf_id;
+    }
+    
+    public function set_book(Book $book)
+    {
+        $this->book = $book;
+    }
+
+    public function get_book()
+    {
+        return $this->book;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "shelf_id" => $this->shelf_id,
+            "book" => $this->book->to_array(),
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class BookService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function getBook(BookRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Book();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "get", "v1/shelves/" . rawurlencode($req->get_shelf_id()) . "/books/" . rawurlencode($req->get_book_id()), $handler);
+    }
+
+    public function createBook(Book $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Book();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "v1/shelves/" . rawurlencode($req->get_shelf_id()) . "/books", $handler);
+    }
+
+    public function updateBook(Book $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Book();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "patch", "v1/shelves/" . rawurlencode($req->get_shelf_id()) . "/books/" . rawurlencode($req->get_book_id()), $handler);
+    }
+
+    /** the single field body and the response_body are not supported, the whole messages are sent */
+    public function moveBook(MoveBookRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Book();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "put", "v1/shelves/" . rawurlencode($req->get_shelf_id()) . "/books", $handler);
+    }
+
+    public function deleteBook(BookRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Book();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "delete", "v1/shelves/" . rawurlencode($req->get_shelf_id()) . "/books/" . rawurlencode($req->get_book_id()), $handler);
+    }
+
+    /** the methods without google.api.http keep the protoapi routes */
+    public function listBooks(BookRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Book();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "BookService.listBooks", $handler);
+    }
+}
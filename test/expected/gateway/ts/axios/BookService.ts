@@ -0,0 +1,197 @@
+/**
+* This file is generated by 'protoapi'
+* The file contains frontend API code that work with the library 'axios', therefore, it's required that 'axios' is installed in the project
+* The generated code is written in TypeScript
+* The code provides a basic usage for API call and may need adjustment according to specific project requirement and situation
+* -------------------------------------------
+* 该文件生成于protoapi
+* 文件包含前端调用API的代码，并使用第三方库axios， 因此需要保证axios存在于项目中
+* 文件内代码使用TypeScript
+* 该生成文件只提供前端API调用基本代码，实际情况可能需要根据具体项目具体要求不同而作出更改
+*/
+import axios, { AxiosPromise } from 'axios';
+import {
+    Book,
+    BookRequest,
+    MoveBookRequest,
+    
+} from './BookServiceObjs';
+import { errorHandling } from './helper';
+
+var baseUrl = "http://192.168.115.60:8080";
+
+export function SetBaseUrl(url: string) {
+    baseUrl = url;
+}
+// use axios
+export function getBook(params: BookRequest): Promise<Book | never> {
+    let url: string = baseUrl + "/v1/shelves/" + encodeURIComponent(String(params.shelf_id)) + "/books/" + encodeURIComponent(String(params.book_id));
+    var config = {
+        "transformResponse" : [function transformResponse(data) {
+            return data;
+        }],
+        headers: {'X-Requested-With': 'XMLHttpRequest'},
+        params: params
+    };
+
+    return axios.get(url, config)
+        .catch(err => {
+            // handle error response
+            return errorHandling(err)
+        }).then(res => {
+            if (typeof res.data === 'string') {
+                try {
+                    var data = JSON.parse(res.data);
+
+                    return Promise.resolve(data as Book)
+                } catch (e) {
+                    return Promise.reject(res.data);
+                }
+            }
+
+            return Promise.reject(res.data);
+        });
+}
+
+export function createBook(params: Book): Promise<Book | never> {
+    let url: string = baseUrl + "/v1/shelves/" + encodeURIComponent(String(params.shelf_id)) + "/books";
+    var config = {
+        "transformResponse" : [function transformResponse(data) {
+            return data;
+        }],
+        headers: {'X-Requested-With': 'XMLHttpRequest'}
+    };
+
+    return axios.post(url, params, config)
+        .catch(err => {
+            // handle error response
+            return errorHandling(err)
+        }).then(res => {
+            if (typeof res.data === 'string') {
+                try {
+                    var data = JSON.parse(res.data);
+
+                    return Promise.resolve(data as Book)
+                } catch (e) {
+                    return Promise.reject(res.data);
+                }
+            }
+
+            return Promise.reject(res.data);
+        });
+}
+
+export function updateBook(params: Book): Promise<Book | never> {
+    let url: string = baseUrl + "/v1/shelves/" + encodeURIComponent(String(params.shelf_id)) + "/books/" + encodeURIComponent(String(params.book_id));
+    var config = {
+        "transformResponse" : [function transformResponse(data) {
+            return data;
+        }],
+        headers: {'X-Requested-With': 'XMLHttpRequest'}
+    };
+
+    return axios.patch(url, params, config)
+        .catch(err => {
+            // handle error response
+            return errorHandling(err)
+        }).then(res => {
+            if (typeof res.data === 'string') {
+                try {
+                    var data = JSON.parse(res.data);
+
+                    return Promise.resolve(data as Book)
+                } catch (e) {
+                    return Promise.reject(res.data);
+                }
+            }
+
+            return Promise.reject(res.data);
+        });
+}
+
+/** the single field body and the response_body are not supported, the whole messages are sent */
+export function moveBook(params: MoveBookRequest): Promise<Book | never> {
+    let url: string = baseUrl + "/v1/shelves/" + encodeURIComponent(String(params.shelf_id)) + "/books";
+    var config = {
+        "transformResponse" : [function transformResponse(data) {
+            return data;
+        }],
+        headers: {'X-Requested-With': 'XMLHttpRequest'}
+    };
+
+    return axios.put(url, params, config)
+        .catch(err => {
+            // handle error response
+            return errorHandling(err)
+        }).then(res => {
+            if (typeof res.data === 'string') {
+                try {
+                    var data = JSON.parse(res.data);
+
+                    return Promise.resolve(data as Book)
+                } catch (e) {
+                    return Promise.reject(res.data);
+                }
+            }
+
+            return Promise.reject(res.data);
+        });
+}
+
+export function deleteBook(params: BookRequest): Promise<Book | never> {
+    let url: string = baseUrl + "/v1/shelves/" + encodeURIComponent(String(params.shelf_id)) + "/books/" + encodeURIComponent(String(params.book_id));
+    var config = {
+        "transformResponse" : [function transformResponse(data) {
+            return data;
+        }],
+        headers: {'X-Requested-With': 'XMLHttpRequest'},
+        params: params
+    };
+
+    return axios.delete(url, config)
+        .catch(err => {
+            // handle error response
+            return errorHandling(err)
+        }).then(res => {
+            if (typeof res.data === 'string') {
+                try {
+                    var data = JSON.parse(res.data);
+
+                    return Promise.resolve(data as Book)
+                } catch (e) {
+                    return Promise.reject(res.data);
+                }
+            }
+
+            return Promise.reject(res.data);
+        });
+}
+
+/** the methods without google.api.http keep the protoapi routes */
+export function listBooks(params: BookRequest): Promise<Book | never> {
+    let url: string = baseUrl + "/BookService.listBooks";
+    var config = {
+        "transformResponse" : [function transformResponse(data) {
+            return data;
+        }],
+        headers: {'X-Requested-With': 'XMLHttpRequest'}
+    };
+
+    return axios.post(url, params, config)
+        .catch(err => {
+            // handle error response
+            return errorHandling(err)
+        }).then(res => {
+            if (typeof res.data === 'string') {
+                try {
+                    var data = JSON.parse(res.data);
+
+                    return Promise.resolve(data as Book)
+                } catch (e) {
+                    return Promise.reject(res.data);
+                }
+            }
+
+            return Promise.reject(res.data);
+        });
+}
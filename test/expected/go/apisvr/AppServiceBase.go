@@ -7,34 +7,49 @@ import (
 	"github.com/yoozoo/protoapi/protoapigo"
 )
 
-// AppService is the interface contains all the controllers
+// This service contains all the rpc related with services
+// option (service_method) can be "POST" or "GET", if not specified, both post
+// and get methods will be supported
 type AppService interface {
 	AppServiceAuth(c echo.Context) (err error)
 
+	// get env
 	GetEnv(c echo.Context, req *EnvListRequest) (resp *EnvListResponse, bizError *Error, err error)
 
+	// register a service
 	RegisterService(c echo.Context, req *RegisterServiceRequest) (resp *RegisterServiceResponse, bizError *Error, err error)
 
+	// update a service
 	UpdateService(c echo.Context, req *UpdateServiceRequest) (resp *UpdateServiceResponse, bizError *Error, err error)
 
+	// upload proto file
 	UploadProtoFile(c echo.Context, req *UploadProtoFileRequest) (resp *UploadProtoFileResponse, bizError *Error, err error)
 
+	// get a list of tags that contains apps
 	GetTags(c echo.Context, req *TagListRequest) (resp *TagListResponse, bizError *Error, err error)
 
+	// get a list of apps under specified tag
 	GetProducts(c echo.Context, req *ProductListRequest) (resp *ProductListResponse, bizError *Error, err error)
 
+	// get a list of services under specified tag
 	GetServices(c echo.Context, req *ServiceListRequest) (resp *ServiceListResponse, bizError *Error, err error)
 
+	// search services
 	SearchServices(c echo.Context, req *ServiceSearchRequest) (resp *ServiceListResponse, bizError *Error, err error)
 
+	// get a list of keys of the specified service
 	GetKeyList(c echo.Context, req *KeyListRequest) (resp *KeyListResponse, bizError *Error, err error)
 
+	// get key-value pairs given the list of keys
 	GetKeyValueList(c echo.Context, req *KeyValueListRequest) (resp *KeyValueListResponse, bizError *Error, err error)
 
+	// search key value list by key or value
 	SearchKeyValueList(c echo.Context, req *SearchKeyValueListRequest) (resp *KeyValueListResponse, bizError *Error, err error)
 
+	// update value by key
 	UpdateKeyValue(c echo.Context, req *KeyValueRequest) (resp *KeyValueResponse, bizError *Error, err error)
 
+	// fetch key's value change history
 	FetchKeyHistory(c echo.Context, req *KVHistoryRequest) (resp *KVHistoryResponse, bizError *Error, err error)
 }
 
@@ -471,18 +486,18 @@ func RegisterAppServiceWithPrefix(e *echo.Echo, srv AppService, prefix string) {
 	if _, ok := e.Binder.(*echo.DefaultBinder); ok {
 		e.Binder = new(protoapigo.JSONAPIBinder)
 	}
-	g := e.Group(prefix+"/AppService", _AppServiceAuth_Handler(srv))
-	g.POST(".getEnv", _getEnv_Handler(srv))
-	g.POST(".registerService", _registerService_Handler(srv))
-	g.POST(".updateService", _updateService_Handler(srv))
-	g.POST(".uploadProtoFile", _uploadProtoFile_Handler(srv))
-	g.POST(".getTags", _getTags_Handler(srv))
-	g.POST(".getProducts", _getProducts_Handler(srv))
-	g.POST(".getServices", _getServices_Handler(srv))
-	g.POST(".searchServices", _searchServices_Handler(srv))
-	g.POST(".getKeyList", _getKeyList_Handler(srv))
-	g.POST(".getKeyValueList", _getKeyValueList_Handler(srv))
-	g.POST(".searchKeyValueList", _searchKeyValueList_Handler(srv))
-	g.POST(".updateKeyValue", _updateKeyValue_Handler(srv))
-	g.POST(".fetchKeyHistory", _fetchKeyHistory_Handler(srv))
+	auth := _AppServiceAuth_Handler(srv)
+	e.POST(prefix+"/AppService.getEnv", _getEnv_Handler(srv), auth)
+	e.POST(prefix+"/AppService.registerService", _registerService_Handler(srv), auth)
+	e.POST(prefix+"/AppService.updateService", _updateService_Handler(srv), auth)
+	e.POST(prefix+"/AppService.uploadProtoFile", _uploadProtoFile_Handler(srv), auth)
+	e.POST(prefix+"/AppService.getTags", _getTags_Handler(srv), auth)
+	e.POST(prefix+"/AppService.getProducts", _getProducts_Handler(srv), auth)
+	e.POST(prefix+"/AppService.getServices", _getServices_Handler(srv), auth)
+	e.POST(prefix+"/AppService.searchServices", _searchServices_Handler(srv), auth)
+	e.POST(prefix+"/AppService.getKeyList", _getKeyList_Handler(srv), auth)
+	e.POST(prefix+"/AppService.getKeyValueList", _getKeyValueList_Handler(srv), auth)
+	e.POST(prefix+"/AppService.searchKeyValueList", _searchKeyValueList_Handler(srv), auth)
+	e.POST(prefix+"/AppService.updateKeyValue", _updateKeyValue_Handler(srv), auth)
+	e.POST(prefix+"/AppService.fetchKeyHistory", _fetchKeyHistory_Handler(srv), auth)
 }
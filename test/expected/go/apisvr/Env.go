@@ -2,15 +2,15 @@
 
 package apisvr
 
-// Env
+// other building blocks
 type Env struct {
-	Env_id   int    `json:"env_id"`
+	Env_id   int32  `json:"env_id"`
 	Env_name string `json:"env_name"`
 }
 
-func (r *Env) GetEnv_id() int {
+func (r *Env) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
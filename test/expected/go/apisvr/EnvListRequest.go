@@ -2,6 +2,6 @@
 
 package apisvr
 
-// EnvListRequest
+// env list - 环境
 type EnvListRequest struct {
 }
@@ -4,21 +4,21 @@ package apisvr
 
 // KVHistoryRequest
 type KVHistoryRequest struct {
-	Service_id int `json:"service_id"`
-	Key_id     int `json:"key_id"`
+	Service_id int32 `json:"service_id"`
+	Key_id     int32 `json:"key_id"`
 }
 
-func (r *KVHistoryRequest) GetService_id() int {
+func (r *KVHistoryRequest) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
 }
 
-func (r *KVHistoryRequest) GetKey_id() int {
+func (r *KVHistoryRequest) GetKey_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Key_id
@@ -4,16 +4,16 @@ package apisvr
 
 // Key
 type Key struct {
-	Key_id       int    `json:"key_id"`
+	Key_id       int32  `json:"key_id"`
 	Key          string `json:"key"`
 	DataType     string `json:"dataType"`
 	DefaultValue string `json:"defaultValue"`
 	IsWatched    bool   `json:"isWatched"`
 }
 
-func (r *Key) GetKey_id() int {
+func (r *Key) GetKey_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Key_id
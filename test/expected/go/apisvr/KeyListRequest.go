@@ -2,23 +2,25 @@
 
 package apisvr
 
-// KeyListRequest
+// key list
+// PS: separate key list & key value because proto and kv store may be on
+// different endpoint
 type KeyListRequest struct {
-	Service_id int `json:"service_id"`
-	Env_id     int `json:"env_id"`
+	Service_id int32 `json:"service_id"`
+	Env_id     int32 `json:"env_id"`
 }
 
-func (r *KeyListRequest) GetService_id() int {
+func (r *KeyListRequest) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
 }
 
-func (r *KeyListRequest) GetEnv_id() int {
+func (r *KeyListRequest) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
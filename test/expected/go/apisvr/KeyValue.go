@@ -4,7 +4,7 @@ package apisvr
 
 // KeyValue
 type KeyValue struct {
-	Key_id       int    `json:"key_id"`
+	Key_id       int32  `json:"key_id"`
 	Key          string `json:"key"`
 	DataType     string `json:"dataType"`
 	DefaultValue string `json:"defaultValue"`
@@ -12,9 +12,9 @@ type KeyValue struct {
 	Value        string `json:"value"`
 }
 
-func (r *KeyValue) GetKey_id() int {
+func (r *KeyValue) GetKey_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Key_id
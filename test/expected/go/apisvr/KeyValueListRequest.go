@@ -2,15 +2,15 @@
 
 package apisvr
 
-// KeyValueListRequest
+// key value
 type KeyValueListRequest struct {
-	Service_id int    `json:"service_id"`
+	Service_id int32  `json:"service_id"`
 	Keys       []*Key `json:"keys"`
 }
 
-func (r *KeyValueListRequest) GetService_id() int {
+func (r *KeyValueListRequest) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
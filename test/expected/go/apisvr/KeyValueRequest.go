@@ -4,13 +4,13 @@ package apisvr
 
 // KeyValueRequest
 type KeyValueRequest struct {
-	Service_id int         `json:"service_id"`
+	Service_id int32       `json:"service_id"`
 	Key_values []*KeyValue `json:"key_values"`
 }
 
-func (r *KeyValueRequest) GetService_id() int {
+func (r *KeyValueRequest) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
@@ -2,14 +2,15 @@
 
 package apisvr
 
-// ProductListRequest
+// product list
 type ProductListRequest struct {
-	Env_id int `json:"env_id"`
+	// DEV, UAT, PROD etc
+	Env_id int32 `json:"env_id"`
 }
 
-func (r *ProductListRequest) GetEnv_id() int {
+func (r *ProductListRequest) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
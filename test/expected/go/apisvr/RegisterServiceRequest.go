@@ -4,16 +4,16 @@ package apisvr
 
 // RegisterServiceRequest
 type RegisterServiceRequest struct {
-	Env_id       int    `json:"env_id"`
+	Env_id       int32  `json:"env_id"`
 	Product_id   string `json:"product_id"`
 	Service_name string `json:"service_name"`
 	Tags         []*Tag `json:"tags"`
 	Desc         string `json:"desc"`
 }
 
-func (r *RegisterServiceRequest) GetEnv_id() int {
+func (r *RegisterServiceRequest) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
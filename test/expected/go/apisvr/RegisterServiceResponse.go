@@ -4,15 +4,15 @@ package apisvr
 
 // RegisterServiceResponse
 type RegisterServiceResponse struct {
-	Env_id       int    `json:"env_id"`
+	Env_id       int32  `json:"env_id"`
 	Product_id   string `json:"product_id"`
-	Service_id   int    `json:"service_id"`
+	Service_id   int32  `json:"service_id"`
 	Service_name string `json:"service_name"`
 }
 
-func (r *RegisterServiceResponse) GetEnv_id() int {
+func (r *RegisterServiceResponse) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
@@ -26,9 +26,9 @@ func (r *RegisterServiceResponse) GetProduct_id() string {
 	return r.Product_id
 }
 
-func (r *RegisterServiceResponse) GetService_id() int {
+func (r *RegisterServiceResponse) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
@@ -5,8 +5,8 @@ package apisvr
 // SearchKeyValueListRequest
 type SearchKeyValueListRequest struct {
 	Key        string `json:"key"`
-	Service_id int    `json:"service_id"`
-	Env_id     int    `json:"env_id"`
+	Service_id int32  `json:"service_id"`
+	Env_id     int32  `json:"env_id"`
 }
 
 func (r *SearchKeyValueListRequest) GetKey() string {
@@ -17,17 +17,17 @@ func (r *SearchKeyValueListRequest) GetKey() string {
 	return r.Key
 }
 
-func (r *SearchKeyValueListRequest) GetService_id() int {
+func (r *SearchKeyValueListRequest) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
 }
 
-func (r *SearchKeyValueListRequest) GetEnv_id() int {
+func (r *SearchKeyValueListRequest) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
@@ -4,7 +4,7 @@ package apisvr
 
 // Service
 type Service struct {
-	Service_id   int    `json:"service_id"`
+	Service_id   int32  `json:"service_id"`
 	Service_name string `json:"service_name"`
 	Product_id   string `json:"product_id"`
 	Product_name string `json:"product_name"`
@@ -12,9 +12,9 @@ type Service struct {
 	Tags         []*Tag `json:"tags"`
 }
 
-func (r *Service) GetService_id() int {
+func (r *Service) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
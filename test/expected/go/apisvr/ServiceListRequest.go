@@ -2,41 +2,42 @@
 
 package apisvr
 
-// ServiceListRequest
+// service list
 type ServiceListRequest struct {
-	Tag_ids []int `json:"tag_ids"`
-	Env_id  int   `json:"env_id"`
-	Offset  int   `json:"offset"`
-	Limit   int   `json:"limit"`
+	// optional, for filter
+	Tag_ids []int32 `json:"tag_ids"`
+	Env_id  int32   `json:"env_id"`
+	Offset  int32   `json:"offset"`
+	Limit   int32   `json:"limit"`
 }
 
-func (r *ServiceListRequest) GetTag_ids() []int {
+func (r *ServiceListRequest) GetTag_ids() []int32 {
 	if r == nil {
-		var zeroVal []int
+		var zeroVal []int32
 		return zeroVal
 	}
 	return r.Tag_ids
 }
 
-func (r *ServiceListRequest) GetEnv_id() int {
+func (r *ServiceListRequest) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
 }
 
-func (r *ServiceListRequest) GetOffset() int {
+func (r *ServiceListRequest) GetOffset() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Offset
 }
 
-func (r *ServiceListRequest) GetLimit() int {
+func (r *ServiceListRequest) GetLimit() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Limit
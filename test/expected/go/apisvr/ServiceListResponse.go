@@ -5,9 +5,9 @@ package apisvr
 // ServiceListResponse
 type ServiceListResponse struct {
 	Services []*Service `json:"services"`
-	Offset   int        `json:"offset"`
-	Limit    int        `json:"limit"`
-	Total    int        `json:"total"`
+	Offset   int32      `json:"offset"`
+	Limit    int32      `json:"limit"`
+	Total    int32      `json:"total"`
 }
 
 func (r *ServiceListResponse) GetServices() []*Service {
@@ -18,25 +18,25 @@ func (r *ServiceListResponse) GetServices() []*Service {
 	return r.Services
 }
 
-func (r *ServiceListResponse) GetOffset() int {
+func (r *ServiceListResponse) GetOffset() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Offset
 }
 
-func (r *ServiceListResponse) GetLimit() int {
+func (r *ServiceListResponse) GetLimit() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Limit
 }
 
-func (r *ServiceListResponse) GetTotal() int {
+func (r *ServiceListResponse) GetTotal() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Total
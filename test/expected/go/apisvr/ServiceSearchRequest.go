@@ -2,18 +2,20 @@
 
 package apisvr
 
-// ServiceSearchRequest
+// service list
 type ServiceSearchRequest struct {
-	Tag_ids []int  `json:"tag_ids"`
-	Prefix  string `json:"prefix"`
-	Env_id  int    `json:"env_id"`
-	Offset  int    `json:"offset"`
-	Limit   int    `json:"limit"`
+	// optional, for filter
+	// string prefix = 2 [ (val_required) = true, (val_format) = "email" ];
+	Tag_ids []int32 `json:"tag_ids"`
+	Prefix  string  `json:"prefix"`
+	Env_id  int32   `json:"env_id"`
+	Offset  int32   `json:"offset"`
+	Limit   int32   `json:"limit"`
 }
 
-func (r *ServiceSearchRequest) GetTag_ids() []int {
+func (r *ServiceSearchRequest) GetTag_ids() []int32 {
 	if r == nil {
-		var zeroVal []int
+		var zeroVal []int32
 		return zeroVal
 	}
 	return r.Tag_ids
@@ -27,25 +29,25 @@ func (r *ServiceSearchRequest) GetPrefix() string {
 	return r.Prefix
 }
 
-func (r *ServiceSearchRequest) GetEnv_id() int {
+func (r *ServiceSearchRequest) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
 }
 
-func (r *ServiceSearchRequest) GetOffset() int {
+func (r *ServiceSearchRequest) GetOffset() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Offset
 }
 
-func (r *ServiceSearchRequest) GetLimit() int {
+func (r *ServiceSearchRequest) GetLimit() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Limit
@@ -54,8 +56,7 @@ func (r *ServiceSearchRequest) GetLimit() int {
 func (r ServiceSearchRequest) Validate() *ValidateError {
 	errs := []*FieldError{}
 	if r.Prefix == "" {
-		e := FIELD_REQUIRED
-		errs = append(errs, &FieldError{FieldName: r.Prefix, ErrorType: &e})
+		errs = append(errs, &FieldError{FieldName: "prefix", ErrorType: FIELD_REQUIRED})
 	}
 	if len(errs) > 0 {
 		return &ValidateError{Errors: errs}
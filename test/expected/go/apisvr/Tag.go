@@ -2,15 +2,15 @@
 
 package apisvr
 
-// Tag
+// 用于分类
 type Tag struct {
-	Tag_id   int    `json:"tag_id"`
+	Tag_id   int32  `json:"tag_id"`
 	Tag_name string `json:"tag_name"`
 }
 
-func (r *Tag) GetTag_id() int {
+func (r *Tag) GetTag_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Tag_id
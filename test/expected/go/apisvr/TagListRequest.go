@@ -2,6 +2,6 @@
 
 package apisvr
 
-// TagListRequest
+// tag list - 用于分类
 type TagListRequest struct {
 }
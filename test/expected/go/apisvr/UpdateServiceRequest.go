@@ -4,14 +4,14 @@ package apisvr
 
 // UpdateServiceRequest
 type UpdateServiceRequest struct {
-	Service_id int    `json:"service_id"`
+	Service_id int32  `json:"service_id"`
 	Tags       []*Tag `json:"tags"`
 	Desc       string `json:"desc"`
 }
 
-func (r *UpdateServiceRequest) GetService_id() int {
+func (r *UpdateServiceRequest) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
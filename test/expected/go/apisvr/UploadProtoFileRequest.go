@@ -4,22 +4,22 @@ package apisvr
 
 // UploadProtoFileRequest
 type UploadProtoFileRequest struct {
-	Service_id int    `json:"service_id"`
-	Env_id     int    `json:"env_id"`
+	Service_id int32  `json:"service_id"`
+	Env_id     int32  `json:"env_id"`
 	File       string `json:"file"`
 }
 
-func (r *UploadProtoFileRequest) GetService_id() int {
+func (r *UploadProtoFileRequest) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
 }
 
-func (r *UploadProtoFileRequest) GetEnv_id() int {
+func (r *UploadProtoFileRequest) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
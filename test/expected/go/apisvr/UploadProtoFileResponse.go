@@ -4,30 +4,30 @@ package apisvr
 
 // UploadProtoFileResponse
 type UploadProtoFileResponse struct {
-	Service_id int `json:"service_id"`
-	Env_id     int `json:"env_id"`
-	Key_count  int `json:"key_count"`
+	Service_id int32 `json:"service_id"`
+	Env_id     int32 `json:"env_id"`
+	Key_count  int32 `json:"key_count"`
 }
 
-func (r *UploadProtoFileResponse) GetService_id() int {
+func (r *UploadProtoFileResponse) GetService_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Service_id
 }
 
-func (r *UploadProtoFileResponse) GetEnv_id() int {
+func (r *UploadProtoFileResponse) GetEnv_id() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Env_id
 }
 
-func (r *UploadProtoFileResponse) GetKey_count() int {
+func (r *UploadProtoFileResponse) GetKey_count() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Key_count
@@ -4,12 +4,12 @@ package calcsvr
 
 // AddResp
 type AddResp struct {
-	Result int `json:"result"`
+	Result int32 `json:"result"`
 }
 
-func (r *AddResp) GetResult() int {
+func (r *AddResp) GetResult() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Result
@@ -61,6 +61,6 @@ func RegisterCalcServiceWithPrefix(e *echo.Echo, srv CalcService, prefix string)
 	if _, ok := e.Binder.(*echo.DefaultBinder); ok {
 		e.Binder = new(protoapigo.JSONAPIBinder)
 	}
-	g := e.Group(prefix+"/CalcService", _CalcServiceAuth_Handler(srv))
-	g.POST(".add", _add_Handler(srv))
+	auth := _CalcServiceAuth_Handler(srv)
+	e.POST(prefix+"/CalcService.add", _add_Handler(srv), auth)
 }
@@ -5,14 +5,24 @@ package calcsvr
 type ValidateErrorType int
 
 const (
-	INVALID_EMAIL  ValidateErrorType = 0
-	FIELD_REQUIRED ValidateErrorType = 1
+	INVALID_EMAIL        ValidateErrorType = 0
+	FIELD_REQUIRED       ValidateErrorType = 1
+	OUT_OF_RANGE         ValidateErrorType = 2
+	INVALID_LENGTH       ValidateErrorType = 3
+	PATTERN_MISMATCH     ValidateErrorType = 4
+	INVALID_ITEM_COUNT   ValidateErrorType = 5
+	UNDEFINED_ENUM_VALUE ValidateErrorType = 6
 )
 
 func (code ValidateErrorType) String() string {
 	names := map[ValidateErrorType]string{
-		INVALID_EMAIL:  "INVALID_EMAIL",
-		FIELD_REQUIRED: "FIELD_REQUIRED",
+		INVALID_EMAIL:        "INVALID_EMAIL",
+		FIELD_REQUIRED:       "FIELD_REQUIRED",
+		OUT_OF_RANGE:         "OUT_OF_RANGE",
+		INVALID_LENGTH:       "INVALID_LENGTH",
+		PATTERN_MISMATCH:     "PATTERN_MISMATCH",
+		INVALID_ITEM_COUNT:   "INVALID_ITEM_COUNT",
+		UNDEFINED_ENUM_VALUE: "UNDEFINED_ENUM_VALUE",
 	}
 
 	return names[code]
@@ -29,3 +39,23 @@ func (code ValidateErrorType) IsINVALID_EMAIL() bool {
 func (code ValidateErrorType) IsFIELD_REQUIRED() bool {
 	return code == FIELD_REQUIRED
 }
+
+func (code ValidateErrorType) IsOUT_OF_RANGE() bool {
+	return code == OUT_OF_RANGE
+}
+
+func (code ValidateErrorType) IsINVALID_LENGTH() bool {
+	return code == INVALID_LENGTH
+}
+
+func (code ValidateErrorType) IsPATTERN_MISMATCH() bool {
+	return code == PATTERN_MISMATCH
+}
+
+func (code ValidateErrorType) IsINVALID_ITEM_COUNT() bool {
+	return code == INVALID_ITEM_COUNT
+}
+
+func (code ValidateErrorType) IsUNDEFINED_ENUM_VALUE() bool {
+	return code == UNDEFINED_ENUM_VALUE
+}
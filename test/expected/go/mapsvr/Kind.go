@@ -0,0 +1,31 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package mapsvr
+
+type Kind int
+
+const (
+	A Kind = 0
+	B Kind = 1
+)
+
+func (code Kind) String() string {
+	names := map[Kind]string{
+		A: "A",
+		B: "B",
+	}
+
+	return names[code]
+}
+
+func (code Kind) Code() int {
+	return (int)(code)
+}
+
+func (code Kind) IsA() bool {
+	return code == A
+}
+
+func (code Kind) IsB() bool {
+	return code == B
+}
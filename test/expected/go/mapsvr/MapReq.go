@@ -0,0 +1,53 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package mapsvr
+
+// MapReq
+type MapReq struct {
+	Items map[string]*Item `json:"items"`
+	Names map[int32]string `json:"names"`
+	Kinds map[string]Kind  `json:"kinds"`
+	List  []*Item          `json:"list"`
+	// bool keys are "true" and "false" in JSON
+	Flags map[string]string `json:"flags"`
+}
+
+func (r *MapReq) GetItems() map[string]*Item {
+	if r == nil {
+		var zeroVal map[string]*Item
+		return zeroVal
+	}
+	return r.Items
+}
+
+func (r *MapReq) GetNames() map[int32]string {
+	if r == nil {
+		var zeroVal map[int32]string
+		return zeroVal
+	}
+	return r.Names
+}
+
+func (r *MapReq) GetKinds() map[string]Kind {
+	if r == nil {
+		var zeroVal map[string]Kind
+		return zeroVal
+	}
+	return r.Kinds
+}
+
+func (r *MapReq) GetList() []*Item {
+	if r == nil {
+		var zeroVal []*Item
+		return zeroVal
+	}
+	return r.List
+}
+
+func (r *MapReq) GetFlags() map[string]string {
+	if r == nil {
+		var zeroVal map[string]string
+		return zeroVal
+	}
+	return r.Flags
+}
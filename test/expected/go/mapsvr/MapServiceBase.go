@@ -0,0 +1,46 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package mapsvr
+
+import (
+	"github.com/labstack/echo"
+	"github.com/yoozoo/protoapi/protoapigo"
+)
+
+// MapService is the interface contains all the controllers
+type MapService interface {
+	Get(c echo.Context, req *MapReq) (resp *MapResp, err error)
+}
+
+func _get_Handler(srv MapService) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		req := new(MapReq)
+
+		if err = c.Bind(req); err != nil {
+			return c.JSON(500, err)
+		}
+		/*
+
+		 */
+		resp, err := srv.Get(c, req)
+		if err != nil {
+			return c.String(500, err.Error())
+		}
+
+		return c.JSON(200, resp)
+	}
+}
+
+// RegisterMapService is used to bind routers
+func RegisterMapService(e *echo.Echo, srv MapService) {
+	RegisterMapServiceWithPrefix(e, srv, "")
+}
+
+// RegisterMapServiceWithPrefix is used to bind routers with custom prefix
+func RegisterMapServiceWithPrefix(e *echo.Echo, srv MapService, prefix string) {
+	// switch to strict JSONAPIBinder, if using echo's DefaultBinder
+	if _, ok := e.Binder.(*echo.DefaultBinder); ok {
+		e.Binder = new(protoapigo.JSONAPIBinder)
+	}
+	e.POST(prefix+"/MapService.get", _get_Handler(srv))
+}
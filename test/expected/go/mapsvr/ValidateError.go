@@ -0,0 +1,16 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package mapsvr
+
+// ValidateError
+type ValidateError struct {
+	Errors []*FieldError `json:"errors"`
+}
+
+func (r *ValidateError) GetErrors() []*FieldError {
+	if r == nil {
+		var zeroVal []*FieldError
+		return zeroVal
+	}
+	return r.Errors
+}
@@ -4,13 +4,13 @@ package nested
 
 // AddResp
 type AddResp struct {
-	Result int    `json:"result"`
+	Result int32  `json:"result"`
 	Extra  *Extra `json:"extra"`
 }
 
-func (r *AddResp) GetResult() int {
+func (r *AddResp) GetResult() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Result
@@ -0,0 +1,46 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package optionalsvr
+
+import (
+	"github.com/labstack/echo"
+	"github.com/yoozoo/protoapi/protoapigo"
+)
+
+// ProfileService is the interface contains all the controllers
+type ProfileService interface {
+	Update(c echo.Context, req *Profile) (resp *Profile, err error)
+}
+
+func _update_Handler(srv ProfileService) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		req := new(Profile)
+
+		if err = c.Bind(req); err != nil {
+			return c.JSON(500, err)
+		}
+		/*
+
+		 */
+		resp, err := srv.Update(c, req)
+		if err != nil {
+			return c.String(500, err.Error())
+		}
+
+		return c.JSON(200, resp)
+	}
+}
+
+// RegisterProfileService is used to bind routers
+func RegisterProfileService(e *echo.Echo, srv ProfileService) {
+	RegisterProfileServiceWithPrefix(e, srv, "")
+}
+
+// RegisterProfileServiceWithPrefix is used to bind routers with custom prefix
+func RegisterProfileServiceWithPrefix(e *echo.Echo, srv ProfileService, prefix string) {
+	// switch to strict JSONAPIBinder, if using echo's DefaultBinder
+	if _, ok := e.Binder.(*echo.DefaultBinder); ok {
+		e.Binder = new(protoapigo.JSONAPIBinder)
+	}
+	e.POST(prefix+"/ProfileService.update", _update_Handler(srv))
+}
@@ -0,0 +1,97 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package reservedsvr
+
+// Item
+type Item struct {
+	Type     string   `json:"type"`
+	Class    string   `json:"class"`
+	Default  bool     `json:"default"`
+	Package  string   `json:"package"`
+	Func     int32    `json:"func"`
+	New      int32    `json:"new"`
+	Var      []string `json:"var"`
+	Kind     Kind     `json:"kind"`
+	Function string   `json:"function"`
+	Status   Status   `json:"status"`
+}
+
+func (r *Item) GetType() string {
+	if r == nil {
+		var zeroVal string
+		return zeroVal
+	}
+	return r.Type
+}
+
+func (r *Item) GetClass() string {
+	if r == nil {
+		var zeroVal string
+		return zeroVal
+	}
+	return r.Class
+}
+
+func (r *Item) GetDefault() bool {
+	if r == nil {
+		var zeroVal bool
+		return zeroVal
+	}
+	return r.Default
+}
+
+func (r *Item) GetPackage() string {
+	if r == nil {
+		var zeroVal string
+		return zeroVal
+	}
+	return r.Package
+}
+
+func (r *Item) GetFunc() int32 {
+	if r == nil {
+		var zeroVal int32
+		return zeroVal
+	}
+	return r.Func
+}
+
+func (r *Item) GetNew() int32 {
+	if r == nil {
+		var zeroVal int32
+		return zeroVal
+	}
+	return r.New
+}
+
+func (r *Item) GetVar() []string {
+	if r == nil {
+		var zeroVal []string
+		return zeroVal
+	}
+	return r.Var
+}
+
+func (r *Item) GetKind() Kind {
+	if r == nil {
+		var zeroVal Kind
+		return zeroVal
+	}
+	return r.Kind
+}
+
+func (r *Item) GetFunction() string {
+	if r == nil {
+		var zeroVal string
+		return zeroVal
+	}
+	return r.Function
+}
+
+func (r *Item) GetStatus() Status {
+	if r == nil {
+		var zeroVal Status
+		return zeroVal
+	}
+	return r.Status
+}
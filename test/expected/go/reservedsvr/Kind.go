@@ -0,0 +1,43 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package reservedsvr
+
+type Kind int
+
+const (
+	Kind_UNKNOWN Kind = 0
+	Kind_PLAIN   Kind = 1
+	Kind_class   Kind = 2
+	Kind_type    Kind = 3
+)
+
+func (code Kind) String() string {
+	names := map[Kind]string{
+		Kind_UNKNOWN: "UNKNOWN",
+		Kind_PLAIN:   "PLAIN",
+		Kind_class:   "class",
+		Kind_type:    "type",
+	}
+
+	return names[code]
+}
+
+func (code Kind) Code() int {
+	return (int)(code)
+}
+
+func (code Kind) IsUNKNOWN() bool {
+	return code == Kind_UNKNOWN
+}
+
+func (code Kind) IsPLAIN() bool {
+	return code == Kind_PLAIN
+}
+
+func (code Kind) Isclass() bool {
+	return code == Kind_class
+}
+
+func (code Kind) Istype() bool {
+	return code == Kind_type
+}
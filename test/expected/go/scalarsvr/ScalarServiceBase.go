@@ -0,0 +1,46 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package scalarsvr
+
+import (
+	"github.com/labstack/echo"
+	"github.com/yoozoo/protoapi/protoapigo"
+)
+
+// ScalarService is the interface contains all the controllers
+type ScalarService interface {
+	Echo(c echo.Context, req *Scalars) (resp *Scalars, err error)
+}
+
+func _echo_Handler(srv ScalarService) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		req := new(Scalars)
+
+		if err = c.Bind(req); err != nil {
+			return c.JSON(500, err)
+		}
+		/*
+
+		 */
+		resp, err := srv.Echo(c, req)
+		if err != nil {
+			return c.String(500, err.Error())
+		}
+
+		return c.JSON(200, resp)
+	}
+}
+
+// RegisterScalarService is used to bind routers
+func RegisterScalarService(e *echo.Echo, srv ScalarService) {
+	RegisterScalarServiceWithPrefix(e, srv, "")
+}
+
+// RegisterScalarServiceWithPrefix is used to bind routers with custom prefix
+func RegisterScalarServiceWithPrefix(e *echo.Echo, srv ScalarService, prefix string) {
+	// switch to strict JSONAPIBinder, if using echo's DefaultBinder
+	if _, ok := e.Binder.(*echo.DefaultBinder); ok {
+		e.Binder = new(protoapigo.JSONAPIBinder)
+	}
+	e.POST(prefix+"/ScalarService.echo", _echo_Handler(srv))
+}
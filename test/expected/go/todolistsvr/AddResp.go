@@ -4,12 +4,12 @@ package todolistsvr
 
 // AddResp
 type AddResp struct {
-	Count int `json:"count"`
+	Count int32 `json:"count"`
 }
 
-func (r *AddResp) GetCount() int {
+func (r *AddResp) GetCount() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Count
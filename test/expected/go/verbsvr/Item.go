@@ -0,0 +1,25 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package verbsvr
+
+// Item
+type Item struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (r *Item) GetId() int32 {
+	if r == nil {
+		var zeroVal int32
+		return zeroVal
+	}
+	return r.Id
+}
+
+func (r *Item) GetName() string {
+	if r == nil {
+		var zeroVal string
+		return zeroVal
+	}
+	return r.Name
+}
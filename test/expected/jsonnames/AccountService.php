@@ -0,0 +1,461 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace jsonnames;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+class Account implements ProtoApi\Message
+{
+    protected $user_name;
+    protected $login_count;
+    /** an explicit json_name always wins */
+    protected $email_address;
+    /** also when it equals the camel form */
+    protected $display_name;
+    protected $role_names;
+    protected $score_by_game;
+    protected $phone_number;
+    protected $wechat_id;
+
+    public function init(array $response)
+    {
+        if (isset($response["user_name"])) {
+            $this->user_name = $response["user_name"];
+        }
+        if (isset($response["login_count"])) {
+            $this->login_count = $response["login_count"];
+        }
+        if (isset($response["mail"])) {
+            $this->email_address = $response["mail"];
+        }
+        if (isset($response["displayName"])) {
+            $this->display_name = $response["displayName"];
+        }
+        if (isset($response["role_names"])) {
+            $this->role_names = array();
+            foreach ($response["role_names"] as $role_names) {
+                $this->role_names[] = $role_names;
+            }
+        }
+        if (isset($response["score_by_game"])) {
+            $this->score_by_game = array();
+            foreach ($response["score_by_game"] as $key => $score_by_game) {
+                $this->score_by_game[$key] = $score_by_game;
+            }
+        }
+        if (isset($response["phone_number"])) {
+            $this->phone_number = $response["phone_number"];
+        }
+        if (isset($response["wechat_id"])) {
+            $this->wechat_id = $response["wechat_id"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->user_name)) {
+            throw new ProtoApi\GeneralException("'user_name' is not exist");
+        }
+        if (!isset($this->login_count)) {
+            throw new ProtoApi\GeneralException("'login_count' is not exist");
+        }
+        if (!isset($this->email_address)) {
+            throw new ProtoApi\GeneralException("'email_address' is not exist");
+        }
+        if (!isset($this->display_name)) {
+            throw new ProtoApi\GeneralException("'display_name' is not exist");
+        }
+        if (!isset($this->role_names)) {
+            throw new ProtoApi\GeneralException("'role_names' is not exist");
+        }
+        if (!isset($this->score_by_game)) {
+            throw new ProtoApi\GeneralException("'score_by_game' is not exist");
+        }
+    }
+    
+    public function set_user_name($user_name)
+    {
+        $this->user_name = $user_name;
+    }
+
+    public function get_user_name()
+    {
+        return $this->user_name;
+    }
+    
+    public function set_login_count($login_count)
+    {
+        $this->login_count = $login_count;
+    }
+
+    public function get_login_count()
+    {
+        return $this->login_count;
+    }
+    
+    public function set_email_address($email_address)
+    {
+        $this->email_address = $email_address;
+    }
+
+    public function get_email_address()
+    {
+        return $this->email_address;
+    }
+    
+    public function set_display_name($display_name)
+    {
+        $this->display_name = $display_name;
+    }
+
+    public function get_display_name()
+    {
+        return $this->display_name;
+    }
+    
+    public function set_role_names($role_names)
+    {
+        $this->role_names = $role_names;
+    }
+
+    public function get_role_names()
+    {
+        return $this->role_names;
+    }
+    
+    public function set_score_by_game(array $score_by_game)
+    {
+        $this->score_by_game = $score_by_game;
+    }
+
+    public function get_score_by_game()
+    {
+        return $this->score_by_game;
+    }
+    
+    public function set_phone_number($phone_number)
+    {
+        $this->phone_number = $phone_number;
+    }
+
+    public function get_phone_number()
+    {
+        return $this->phone_number;
+    }
+    
+    public function set_wechat_id($wechat_id)
+    {
+        $this->wechat_id = $wechat_id;
+    }
+
+    public function get_wechat_id()
+    {
+        return $this->wechat_id;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "user_name" => $this->user_name,
+            "login_count" => $this->login_count,
+            "mail" => $this->email_address,
+            "displayName" => $this->display_name,
+            "role_names" => $this->role_names,
+            "score_by_game" => $this->score_by_game,
+            "phone_number" => $this->phone_number,
+            "wechat_id" => $this->wechat_id,
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class AccountService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function get(Account $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Account();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "AccountService.get", $handler);
+    }
+}
@@ -0,0 +1,51 @@
+/**
+* This file is generated by 'protoapi'
+* The file contains frontend API code that work with the library 'axios', therefore, it's required that 'axios' is installed in the project
+* The generated code is written in TypeScript
+* The code provides a basic usage for API call and may need adjustment according to specific project requirement and situation
+* -------------------------------------------
+* 该文件生成于protoapi
+* 文件包含前端调用API的代码，并使用第三方库axios， 因此需要保证axios存在于项目中
+* 文件内代码使用TypeScript
+* 该生成文件只提供前端API调用基本代码，实际情况可能需要根据具体项目具体要求不同而作出更改
+*/
+import axios, { AxiosPromise } from 'axios';
+import {
+    Account,
+    
+} from './AccountServiceObjs';
+import { errorHandling } from './helper';
+
+var baseUrl = "http://192.168.115.60:8080";
+
+export function SetBaseUrl(url: string) {
+    baseUrl = url;
+}
+// use axios
+export function get(params: Account): Promise<Account | never> {
+    let url: string = baseUrl + "/AccountService.get";
+    var config = {
+        "transformResponse" : [function transformResponse(data) {
+            return data;
+        }],
+        headers: {'X-Requested-With': 'XMLHttpRequest'}
+    };
+
+    return axios.post(url, params, config)
+        .catch(err => {
+            // handle error response
+            return errorHandling(err)
+        }).then(res => {
+            if (typeof res.data === 'string') {
+                try {
+                    var data = JSON.parse(res.data);
+
+                    return Promise.resolve(data as Account)
+                } catch (e) {
+                    return Promise.reject(res.data);
+                }
+            }
+
+            return Promise.reject(res.data);
+        });
+}
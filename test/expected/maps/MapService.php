@@ -0,0 +1,508 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace maps;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+class Item implements ProtoApi\Message
+{
+    protected $name;
+
+    public function init(array $response)
+    {
+        if (isset($response["name"])) {
+            $this->name = $response["name"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->name)) {
+            throw new ProtoApi\GeneralException("'name' is not exist");
+        }
+    }
+    
+    public function set_name($name)
+    {
+        $this->name = $name;
+    }
+
+    public function get_name()
+    {
+        return $this->name;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "name" => $this->name,
+        );
+    }
+}
+
+class MapReq implements ProtoApi\Message
+{
+    protected $items;
+    protected $names;
+    protected $kinds;
+    protected $list;
+    /** bool keys are "true" and "false" in JSON */
+    protected $flags;
+
+    public function init(array $response)
+    {
+        if (isset($response["items"])) {
+            $this->items = array();
+            foreach ($response["items"] as $key => $items) {
+                $tmp = new Item();
+                $tmp->init($items);
+                $tmp->validate();
+                $this->items[$key] = $tmp;
+            }
+        }
+        if (isset($response["names"])) {
+            $this->names = array();
+            foreach ($response["names"] as $key => $names) {
+                $this->names[$key] = $names;
+            }
+        }
+        if (isset($response["kinds"])) {
+            $this->kinds = array();
+            foreach ($response["kinds"] as $key => $kinds) {
+                $this->kinds[$key] = $kinds;
+            }
+        }
+        if (isset($response["list"])) {
+            $this->list = array();
+            foreach ($response["list"] as $list) {
+                $tmp = new Item();
+                $tmp->init($list);
+                $tmp->validate();
+                $this->list[] = $tmp;
+            }
+        }
+        if (isset($response["flags"])) {
+            $this->flags = array();
+            foreach ($response["flags"] as $key => $flags) {
+                $this->flags[$key] = $flags;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->items)) {
+            throw new ProtoApi\GeneralException("'items' is not exist");
+        }
+        if (!isset($this->names)) {
+            throw new ProtoApi\GeneralException("'names' is not exist");
+        }
+        if (!isset($this->kinds)) {
+            throw new ProtoApi\GeneralException("'kinds' is not exist");
+        }
+        if (!isset($this->list)) {
+            throw new ProtoApi\GeneralException("'list' is not exist");
+        }
+        if (!isset($this->flags)) {
+            throw new ProtoApi\GeneralException("'flags' is not exist");
+        }
+    }
+    
+    public function set_items(array $items)
+    {
+        $this->items = $items;
+    }
+
+    public function get_items()
+    {
+        return $this->items;
+    }
+    
+    public function set_names(array $names)
+    {
+        $this->names = $names;
+    }
+
+    public function get_names()
+    {
+        return $this->names;
+    }
+    
+    public function set_kinds(array $kinds)
+    {
+        $this->kinds = $kinds;
+    }
+
+    public function get_kinds()
+    {
+        return $this->kinds;
+    }
+    
+    public function set_list(List $list)
+    {
+        $this->list = $list;
+    }
+
+    public function get_list()
+    {
+        return $this->list;
+    }
+    
+    public function set_flags(array $flags)
+    {
+        $this->flags = $flags;
+    }
+
+    public function get_flags()
+    {
+        return $this->flags;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "items" => array_map(function ($v) { return $v->to_array(); }, $this->items),
+            "names" => $this->names,
+            "kinds" => $this->kinds,
+            "list" => $this->list->to_array(),
+            "flags" => $this->flags,
+        );
+    }
+}
+
+class MapResp implements ProtoApi\Message
+{
+    protected $counts;
+
+    public function init(array $response)
+    {
+        if (isset($response["counts"])) {
+            $this->counts = array();
+            foreach ($response["counts"] as $key => $counts) {
+                $this->counts[$key] = $counts;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->counts)) {
+            throw new ProtoApi\GeneralException("'counts' is not exist");
+        }
+    }
+    
+    public function set_counts(array $counts)
+    {
+        $this->counts = $counts;
+    }
+
+    public function get_counts()
+    {
+        return $this->counts;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "counts" => $this->counts,
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class Kind extends Enum
+{
+    const A = 0;
+    const B = 1;
+}
+
+class MapService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function get(MapReq $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new MapResp();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "MapService.get", $handler);
+    }
+}
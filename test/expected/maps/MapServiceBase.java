@@ -0,0 +1,19 @@
+// Code generated by protoapi; DO NOT EDIT.
+
+package maps;
+
+import org.springframework.web.bind.annotation.GetMapping;
+import org.springframework.web.bind.annotation.PostMapping;
+import org.springframework.web.bind.annotation.ResponseBody;
+import org.springframework.web.bind.annotation.RequestBody;
+
+public abstract class MapServiceBase {
+    @PostMapping("/MapService.get")
+    @ResponseBody
+    public MapResp getPost(@RequestBody MapReq in) {
+        return get(in);
+    }
+
+    abstract MapResp get(MapReq in);
+    
+}
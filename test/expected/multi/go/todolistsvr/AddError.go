@@ -0,0 +1,25 @@
+// Code generated by protoapi:go; DO NOT EDIT.
+
+package todolistsvr
+
+// AddError
+type AddError struct {
+	Req   *AddReq `json:"req"`
+	Error string  `json:"error"`
+}
+
+func (r *AddError) GetReq() *AddReq {
+	if r == nil {
+		var zeroVal *AddReq
+		return zeroVal
+	}
+	return r.Req
+}
+
+func (r *AddError) GetError() string {
+	if r == nil {
+		var zeroVal string
+		return zeroVal
+	}
+	return r.Error
+}
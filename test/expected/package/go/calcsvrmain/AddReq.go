@@ -4,21 +4,21 @@ package calcsvrmain
 
 // AddReq
 type AddReq struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+	X int32 `json:"x"`
+	Y int32 `json:"y"`
 }
 
-func (r *AddReq) GetX() int {
+func (r *AddReq) GetX() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.X
 }
 
-func (r *AddReq) GetY() int {
+func (r *AddReq) GetY() int32 {
 	if r == nil {
-		var zeroVal int
+		var zeroVal int32
 		return zeroVal
 	}
 	return r.Y
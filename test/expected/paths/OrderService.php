@@ -0,0 +1,598 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace paths;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+class OrderListRequest implements ProtoApi\Message
+{
+    protected $user_id;
+    protected $state;
+    protected $limit;
+    protected $reference;
+    protected $min_amount;
+
+    public function init(array $response)
+    {
+        if (isset($response["user_id"])) {
+            $this->user_id = $response["user_id"];
+        }
+        if (isset($response["state"])) {
+            $this->state = $response["state"];
+        }
+        if (isset($response["limit"])) {
+            $this->limit = $response["limit"];
+        }
+        if (isset($response["reference"])) {
+            $this->reference = $response["reference"];
+        }
+        if (isset($response["min_amount"])) {
+            $this->min_amount = $response["min_amount"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->user_id)) {
+            throw new ProtoApi\GeneralException("'user_id' is not exist");
+        }
+        if (!isset($this->state)) {
+            throw new ProtoApi\GeneralException("'state' is not exist");
+        }
+        if (!isset($this->limit)) {
+            throw new ProtoApi\GeneralException("'limit' is not exist");
+        }
+    }
+    
+    public function set_user_id($user_id)
+    {
+        $this->user_id = $user_id;
+    }
+
+    public function get_user_id()
+    {
+        return $this->user_id;
+    }
+    
+    public function set_state($state)
+    {
+        $this->state = $state;
+    }
+
+    public function get_state()
+    {
+        return $this->state;
+    }
+    
+    public function set_limit($limit)
+    {
+        $this->limit = $limit;
+    }
+
+    public function get_limit()
+    {
+        return $this->limit;
+    }
+    
+    public function set_reference($reference)
+    {
+        $this->reference = $reference;
+    }
+
+    public function get_reference()
+    {
+        return $this->reference;
+    }
+    
+    public function set_min_amount($min_amount)
+    {
+        $this->min_amount = $min_amount;
+    }
+
+    public function get_min_amount()
+    {
+        return $this->min_amount;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "user_id" => $this->user_id,
+            "state" => $this->state,
+            "limit" => $this->limit,
+            "reference" => $this->reference,
+            "min_amount" => $this->min_amount,
+        );
+    }
+}
+
+class Order implements ProtoApi\Message
+{
+    protected $user_id;
+    protected $order_id;
+    protected $amount;
+
+    public function init(array $response)
+    {
+        if (isset($response["user_id"])) {
+            $this->user_id = $response["user_id"];
+        }
+        if (isset($response["order_id"])) {
+            $this->order_id = $response["order_id"];
+        }
+        if (isset($response["amount"])) {
+            $this->amount = $response["amount"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->user_id)) {
+            throw new ProtoApi\GeneralException("'user_id' is not exist");
+        }
+        if (!isset($this->order_id)) {
+            throw new ProtoApi\GeneralException("'order_id' is not exist");
+        }
+        if (!isset($this->amount)) {
+            throw new ProtoApi\GeneralException("'amount' is not exist");
+        }
+    }
+    
+    public function set_user_id($user_id)
+    {
+        $this->user_id = $user_id;
+    }
+
+    public function get_user_id()
+    {
+        return $this->user_id;
+    }
+    
+    public function set_order_id($order_id)
+    {
+        $this->order_id = $order_id;
+    }
+
+    public function get_order_id()
+    {
+        return $this->order_id;
+    }
+    
+    public function set_amount($amount)
+    {
+        $this->amount = $amount;
+    }
+
+    public function get_amount()
+    {
+        return $this->amount;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "user_id" => $this->user_id,
+            "order_id" => $this->order_id,
+            "amount" => $this->amount,
+        );
+    }
+}
+
+class OrderList implements ProtoApi\Message
+{
+    protected $orders;
+
+    public function init(array $response)
+    {
+        if (isset($response["orders"])) {
+            $this->orders = array();
+            foreach ($response["orders"] as $orders) {
+                $tmp = new Order();
+                $tmp->init($orders);
+                $tmp->validate();
+                $this->orders[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->orders)) {
+            throw new ProtoApi\GeneralException("'orders' is not exist");
+        }
+    }
+    
+    public function set_orders(Orders $orders)
+    {
+        $this->orders = $orders;
+    }
+
+    public function get_orders()
+    {
+        return $this->orders;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "orders" => $this->orders->to_array(),
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class OrderState extends Enum
+{
+    const OPEN = 0;
+    const CLOSED = 1;
+}
+
+class OrderService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function listOrders(OrderListRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new OrderList();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "get", "api/v1/users/" . rawurlencode($req->get_user_id()) . "/orders/" . rawurlencode($req->get_state()), $handler);
+    }
+
+    public function updateOrder(Order $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Order();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "put", "api/v1/users/" . rawurlencode($req->get_user_id()) . "/orders/" . rawurlencode($req->get_order_id()), $handler);
+    }
+
+    /** the default path is prefixed by the base path too */
+    public function createOrder(Order $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Order();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "api/v1/OrderService.createOrder", $handler);
+    }
+}
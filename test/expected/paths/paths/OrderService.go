@@ -0,0 +1,325 @@
+// This is a file generated by protoapi (version.uuzu.com/protoapi)
+// Generated at: 18 Oct 26 07:29 UTC
+// DO NOT EDIT.
+
+package paths
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+type OrderService struct {
+	apiURL string
+}
+
+func (p *OrderService) SetApiURL(url string) {
+	p.apiURL = url
+}
+
+type CommonError struct {
+	GenericError  *GenericError  `json:"genericError"`
+	AuthError     *AuthError     `json:"authError"`
+	ValidateError *ValidateError `json:"validateError"`
+	BindError     *BindError     `json:"bindError"`
+}
+
+func (e *CommonError) Error() string {
+	return "common error"
+}
+
+type GenericError struct {
+	Message string `json:"message"`
+}
+
+func (e *GenericError) Error() string {
+	return "biz error"
+}
+
+type AuthError struct {
+	Message string `json:"message"`
+}
+
+func (e *AuthError) Error() string {
+	return "biz error"
+}
+
+type BindError struct {
+	Message string `json:"message"`
+}
+
+func (e *BindError) Error() string {
+	return "biz error"
+}
+
+type ValidateError struct {
+	Errors []*FieldError `json:"errors"`
+}
+
+func (e *ValidateError) Error() string {
+	return "biz error"
+}
+
+type FieldError struct {
+	FieldName string            `json:"fieldName"`
+	ErrorType ValidateErrorType `json:"errorType"`
+}
+type Empty struct {
+}
+type OrderListRequest struct {
+	User_id    int64      `json:"user_id"`
+	State      OrderState `json:"state"`
+	Limit      int32      `json:"limit"`
+	Reference  string     `json:"reference"`
+	Min_amount int32      `json:"min_amount"`
+}
+type Order struct {
+	User_id  int64  `json:"user_id"`
+	Order_id string `json:"order_id"`
+	Amount   int32  `json:"amount"`
+}
+type OrderList struct {
+	Orders []*Order `json:"orders"`
+}
+type ValidateErrorType int
+
+const (
+	INVALID_EMAIL        ValidateErrorType = 0
+	FIELD_REQUIRED       ValidateErrorType = 1
+	OUT_OF_RANGE         ValidateErrorType = 2
+	INVALID_LENGTH       ValidateErrorType = 3
+	PATTERN_MISMATCH     ValidateErrorType = 4
+	INVALID_ITEM_COUNT   ValidateErrorType = 5
+	UNDEFINED_ENUM_VALUE ValidateErrorType = 6
+)
+
+func (code ValidateErrorType) String() string {
+	names := map[ValidateErrorType]string{
+		INVALID_EMAIL:        "INVALID_EMAIL",
+		FIELD_REQUIRED:       "FIELD_REQUIRED",
+		OUT_OF_RANGE:         "OUT_OF_RANGE",
+		INVALID_LENGTH:       "INVALID_LENGTH",
+		PATTERN_MISMATCH:     "PATTERN_MISMATCH",
+		INVALID_ITEM_COUNT:   "INVALID_ITEM_COUNT",
+		UNDEFINED_ENUM_VALUE: "UNDEFINED_ENUM_VALUE",
+	}
+
+	return names[code]
+}
+
+func (code ValidateErrorType) Code() int {
+	return (int)(code)
+}
+func (code ValidateErrorType) IsINVALID_EMAIL() bool {
+	return code == INVALID_EMAIL
+}
+func (code ValidateErrorType) IsFIELD_REQUIRED() bool {
+	return code == FIELD_REQUIRED
+}
+func (code ValidateErrorType) IsOUT_OF_RANGE() bool {
+	return code == OUT_OF_RANGE
+}
+func (code ValidateErrorType) IsINVALID_LENGTH() bool {
+	return code == INVALID_LENGTH
+}
+func (code ValidateErrorType) IsPATTERN_MISMATCH() bool {
+	return code == PATTERN_MISMATCH
+}
+func (code ValidateErrorType) IsINVALID_ITEM_COUNT() bool {
+	return code == INVALID_ITEM_COUNT
+}
+func (code ValidateErrorType) IsUNDEFINED_ENUM_VALUE() bool {
+	return code == UNDEFINED_ENUM_VALUE
+}
+
+type OrderState int
+
+const (
+	OPEN   OrderState = 0
+	CLOSED OrderState = 1
+)
+
+func (code OrderState) String() string {
+	names := map[OrderState]string{
+		OPEN:   "OPEN",
+		CLOSED: "CLOSED",
+	}
+
+	return names[code]
+}
+
+func (code OrderState) Code() int {
+	return (int)(code)
+}
+func (code OrderState) IsOPEN() bool {
+	return code == OPEN
+}
+func (code OrderState) IsCLOSED() bool {
+	return code == CLOSED
+}
+
+func (p *OrderService) ListOrders(reqData *OrderListRequest) (resData *OrderList, err error) {
+	url := p.apiURL + "api/v1/users/" + pathParam(reqData.User_id) + "/orders/" + pathParam(reqData.State.Code())
+	query, err := queryString(reqData)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", url+query, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	jsonByte, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode {
+	case 200:
+		resData := new(OrderList)
+		err = json.Unmarshal(jsonByte, resData)
+		if err != nil {
+			return nil, err
+		}
+		return resData, nil
+	case 420:
+		comErr := &CommonError{}
+		err = json.Unmarshal(jsonByte, comErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, comErr
+	case 500:
+		return nil, errors.New("internal server error : " + string(jsonByte))
+	default:
+		return nil, errors.New("unknown status code")
+	}
+}
+func (p *OrderService) UpdateOrder(reqData *Order) (resData *Order, err error) {
+	url := p.apiURL + "api/v1/users/" + pathParam(reqData.User_id) + "/orders/" + pathParam(reqData.Order_id)
+	jsonStr, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	jsonByte, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode {
+	case 200:
+		resData := new(Order)
+		err = json.Unmarshal(jsonByte, resData)
+		if err != nil {
+			return nil, err
+		}
+		return resData, nil
+	case 420:
+		comErr := &CommonError{}
+		err = json.Unmarshal(jsonByte, comErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, comErr
+	case 500:
+		return nil, errors.New("internal server error : " + string(jsonByte))
+	default:
+		return nil, errors.New("unknown status code")
+	}
+}
+
+// the default path is prefixed by the base path too
+func (p *OrderService) CreateOrder(reqData *Order) (resData *Order, err error) {
+	url := p.apiURL + "api/v1/OrderService.createOrder"
+	jsonStr, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	jsonByte, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode {
+	case 200:
+		resData := new(Order)
+		err = json.Unmarshal(jsonByte, resData)
+		if err != nil {
+			return nil, err
+		}
+		return resData, nil
+	case 420:
+		comErr := &CommonError{}
+		err = json.Unmarshal(jsonByte, comErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, comErr
+	case 500:
+		return nil, errors.New("internal server error : " + string(jsonByte))
+	default:
+		return nil, errors.New("unknown status code")
+	}
+}
+
+// pathParam formats a path parameter of the request url
+func pathParam(v interface{}) string {
+	return url.PathEscape(fmt.Sprint(v))
+}
+
+// queryString encodes the request as the query string of the url, messages and lists are given in JSON
+func queryString(reqData interface{}) (string, error) {
+	jsonStr, err := json.Marshal(reqData)
+	if err != nil {
+		return "", err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(jsonStr, &fields); err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	for key, value := range fields {
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			s = string(value)
+		}
+		query.Set(key, s)
+	}
+	if len(query) == 0 {
+		return "", nil
+	}
+	return "?" + query.Encode(), nil
+}
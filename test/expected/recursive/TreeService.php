@@ -0,0 +1,676 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace recursive;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+/** Category is a node of the category tree */
+class Category implements ProtoApi\Message
+{
+    protected $id;
+    protected $name;
+    /** the parent category, not set for the roots */
+    protected $parent;
+    protected $children;
+    protected $aliases;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+        if (isset($response["name"])) {
+            $this->name = $response["name"];
+        }
+        if (isset($response["parent"])) {
+            $this->parent = new Category();
+            $this->parent->init($response["parent"]);
+            $this->parent->validate();
+        }
+        if (isset($response["children"])) {
+            $this->children = array();
+            foreach ($response["children"] as $children) {
+                $tmp = new Category();
+                $tmp->init($children);
+                $tmp->validate();
+                $this->children[] = $tmp;
+            }
+        }
+        if (isset($response["aliases"])) {
+            $this->aliases = array();
+            foreach ($response["aliases"] as $key => $aliases) {
+                $tmp = new Category();
+                $tmp->init($aliases);
+                $tmp->validate();
+                $this->aliases[$key] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+        if (!isset($this->name)) {
+            throw new ProtoApi\GeneralException("'name' is not exist");
+        }
+        if (!isset($this->children)) {
+            throw new ProtoApi\GeneralException("'children' is not exist");
+        }
+        if (!isset($this->aliases)) {
+            throw new ProtoApi\GeneralException("'aliases' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function set_name($name)
+    {
+        $this->name = $name;
+    }
+
+    public function get_name()
+    {
+        return $this->name;
+    }
+    
+    public function set_parent(Parent $parent)
+    {
+        $this->parent = $parent;
+    }
+
+    public function get_parent()
+    {
+        return $this->parent;
+    }
+    
+    public function set_children(Children $children)
+    {
+        $this->children = $children;
+    }
+
+    public function get_children()
+    {
+        return $this->children;
+    }
+    
+    public function set_aliases(array $aliases)
+    {
+        $this->aliases = $aliases;
+    }
+
+    public function get_aliases()
+    {
+        return $this->aliases;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+            "name" => $this->name,
+            "parent" => $this->parent === null ? null : $this->parent->to_array(),
+            "children" => $this->children->to_array(),
+            "aliases" => array_map(function ($v) { return $v->to_array(); }, $this->aliases),
+        );
+    }
+}
+
+/** Comment is a comment of a thread, the replies refer back to it */
+class Comment implements ProtoApi\Message
+{
+    protected $id;
+    protected $text;
+    protected $thread;
+    protected $reply_to;
+    protected $category;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+        if (isset($response["text"])) {
+            $this->text = $response["text"];
+        }
+        if (isset($response["thread"])) {
+            $this->thread = new Thread();
+            $this->thread->init($response["thread"]);
+            $this->thread->validate();
+        }
+        if (isset($response["reply_to"])) {
+            $this->reply_to = new Comment();
+            $this->reply_to->init($response["reply_to"]);
+            $this->reply_to->validate();
+        }
+        if (isset($response["category"])) {
+            $this->category = new Category();
+            $this->category->init($response["category"]);
+            $this->category->validate();
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+        if (!isset($this->text)) {
+            throw new ProtoApi\GeneralException("'text' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function set_text($text)
+    {
+        $this->text = $text;
+    }
+
+    public function get_text()
+    {
+        return $this->text;
+    }
+    
+    public function set_thread(Thread $thread)
+    {
+        $this->thread = $thread;
+    }
+
+    public function get_thread()
+    {
+        return $this->thread;
+    }
+    
+    public function set_reply_to(Reply_to $reply_to)
+    {
+        $this->reply_to = $reply_to;
+    }
+
+    public function get_reply_to()
+    {
+        return $this->reply_to;
+    }
+    
+    public function set_category(Category $category)
+    {
+        $this->category = $category;
+    }
+
+    public function get_category()
+    {
+        return $this->category;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+            "text" => $this->text,
+            "thread" => $this->thread === null ? null : $this->thread->to_array(),
+            "reply_to" => $this->reply_to === null ? null : $this->reply_to->to_array(),
+            "category" => $this->category->to_array(),
+        );
+    }
+}
+
+class Thread implements ProtoApi\Message
+{
+    protected $id;
+    protected $comments;
+    protected $pinned;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+        if (isset($response["comments"])) {
+            $this->comments = array();
+            foreach ($response["comments"] as $comments) {
+                $tmp = new Comment();
+                $tmp->init($comments);
+                $tmp->validate();
+                $this->comments[] = $tmp;
+            }
+        }
+        if (isset($response["pinned"])) {
+            $this->pinned = new Comment();
+            $this->pinned->init($response["pinned"]);
+            $this->pinned->validate();
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+        if (!isset($this->comments)) {
+            throw new ProtoApi\GeneralException("'comments' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function set_comments(Comments $comments)
+    {
+        $this->comments = $comments;
+    }
+
+    public function get_comments()
+    {
+        return $this->comments;
+    }
+    
+    public function set_pinned(Pinned $pinned)
+    {
+        $this->pinned = $pinned;
+    }
+
+    public function get_pinned()
+    {
+        return $this->pinned;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+            "comments" => $this->comments->to_array(),
+            "pinned" => $this->pinned === null ? null : $this->pinned->to_array(),
+        );
+    }
+}
+
+class TreeRequest implements ProtoApi\Message
+{
+    protected $id;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class TreeService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function getTree(TreeRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Category();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "TreeService.getTree", $handler);
+    }
+
+    public function getThread(TreeRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Thread();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "TreeService.getThread", $handler);
+    }
+}
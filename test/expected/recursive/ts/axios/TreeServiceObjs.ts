@@ -0,0 +1,81 @@
+/**
+* This file is generated by 'protoapi'
+* This file contains all the data structure being used in the generated ts services
+* -----------------------------------------------------
+* 该文件生成于protoapi
+* 文件包含API前端调用所引用的数据结构定义
+*/
+
+// enums
+export enum ValidateErrorType {
+    INVALID_EMAIL = 0,
+    FIELD_REQUIRED = 1,
+    OUT_OF_RANGE = 2,
+    INVALID_LENGTH = 3,
+    PATTERN_MISMATCH = 4,
+    INVALID_ITEM_COUNT = 5,
+    UNDEFINED_ENUM_VALUE = 6,
+}
+
+// data types
+export interface CommonError {
+    genericError: GenericError
+    authError: AuthError
+    validateError: ValidateError
+    bindError: BindError
+}
+
+export interface GenericError {
+    message: string
+}
+
+export interface AuthError {
+    message: string
+}
+
+export interface BindError {
+    message: string
+}
+
+export interface ValidateError {
+    errors: FieldError[]
+}
+
+export interface FieldError {
+    fieldName: string
+    errorType: ValidateErrorType
+}
+
+export interface Empty {
+}
+
+/** Category is a node of the category tree */
+export interface Category {
+    id: number
+    name: string
+    /** the parent category, not set for the roots */
+    parent: Category | null
+    children: Category[]
+    aliases: { [key: string]: Category }
+}
+
+/** Comment is a comment of a thread, the replies refer back to it */
+export type Comment = {
+    id: number
+    text: string
+    thread: Thread | null
+} & (
+    | { reply_to: Comment; category?: never; }
+    | { reply_to?: never; category: Category; }
+    | { reply_to?: never; category?: never; }
+)
+
+export interface Thread {
+    id: number
+    comments: Comment[]
+    pinned?: Comment | null
+}
+
+export interface TreeRequest {
+    id: number
+}
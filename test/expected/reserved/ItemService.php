@@ -0,0 +1,636 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace reserved;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+class Item implements ProtoApi\Message
+{
+    protected $type;
+    protected $class;
+    protected $default;
+    protected $package;
+    protected $func;
+    protected $new;
+    protected $var;
+    protected $kind;
+    protected $function;
+    protected $status;
+
+    public function init(array $response)
+    {
+        if (isset($response["type"])) {
+            $this->type = $response["type"];
+        }
+        if (isset($response["class"])) {
+            $this->class = $response["class"];
+        }
+        if (isset($response["default"])) {
+            $this->default = $response["default"];
+        }
+        if (isset($response["package"])) {
+            $this->package = $response["package"];
+        }
+        if (isset($response["func"])) {
+            $this->func = $response["func"];
+        }
+        if (isset($response["new"])) {
+            $this->new = $response["new"];
+        }
+        if (isset($response["var"])) {
+            $this->var = array();
+            foreach ($response["var"] as $var) {
+                $this->var[] = $var;
+            }
+        }
+        if (isset($response["kind"])) {
+            $this->kind = $response["kind"];
+        }
+        if (isset($response["function"])) {
+            $this->function = $response["function"];
+        }
+        if (isset($response["status"])) {
+            $this->status = $response["status"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->type)) {
+            throw new ProtoApi\GeneralException("'type' is not exist");
+        }
+        if (!isset($this->class)) {
+            throw new ProtoApi\GeneralException("'class' is not exist");
+        }
+        if (!isset($this->default)) {
+            throw new ProtoApi\GeneralException("'default' is not exist");
+        }
+        if (!isset($this->package)) {
+            throw new ProtoApi\GeneralException("'package' is not exist");
+        }
+        if (!isset($this->func)) {
+            throw new ProtoApi\GeneralException("'func' is not exist");
+        }
+        if (!isset($this->new)) {
+            throw new ProtoApi\GeneralException("'new' is not exist");
+        }
+        if (!isset($this->var)) {
+            throw new ProtoApi\GeneralException("'var' is not exist");
+        }
+        if (!isset($this->kind)) {
+            throw new ProtoApi\GeneralException("'kind' is not exist");
+        }
+        if (!isset($this->function)) {
+            throw new ProtoApi\GeneralException("'function' is not exist");
+        }
+        if (!isset($this->status)) {
+            throw new ProtoApi\GeneralException("'status' is not exist");
+        }
+    }
+    
+    public function set_type($type)
+    {
+        $this->type = $type;
+    }
+
+    public function get_type()
+    {
+        return $this->type;
+    }
+    
+    public function set_class($class)
+    {
+        $this->class = $class;
+    }
+
+    public function get_class()
+    {
+        return $this->class;
+    }
+    
+    public function set_default($default)
+    {
+        $this->default = $default;
+    }
+
+    public function get_default()
+    {
+        return $this->default;
+    }
+    
+    public function set_package($package)
+    {
+        $this->package = $package;
+    }
+
+    public function get_package()
+    {
+        return $this->package;
+    }
+    
+    public function set_func($func)
+    {
+        $this->func = $func;
+    }
+
+    public function get_func()
+    {
+        return $this->func;
+    }
+    
+    public function set_new($new)
+    {
+        $this->new = $new;
+    }
+
+    public function get_new()
+    {
+        return $this->new;
+    }
+    
+    public function set_var($var)
+    {
+        $this->var = $var;
+    }
+
+    public function get_var()
+    {
+        return $this->var;
+    }
+    
+    public function set_kind($kind)
+    {
+        $this->kind = $kind;
+    }
+
+    public function get_kind()
+    {
+        return $this->kind;
+    }
+    
+    public function set_function($function)
+    {
+        $this->function = $function;
+    }
+
+    public function get_function()
+    {
+        return $this->function;
+    }
+    
+    public function set_status($status)
+    {
+        $this->status = $status;
+    }
+
+    public function get_status()
+    {
+        return $this->status;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "type" => $this->type,
+            "class" => $this->class,
+            "default" => $this->default,
+            "package" => $this->package,
+            "func" => $this->func,
+            "new" => $this->new,
+            "var" => $this->var,
+            "kind" => $this->kind,
+            "function" => $this->function,
+            "status" => $this->status,
+        );
+    }
+}
+
+class PBClone implements ProtoApi\Message
+{
+    protected $items;
+    protected $int;
+    protected $mode;
+
+    public function init(array $response)
+    {
+        if (isset($response["items"])) {
+            $this->items = array();
+            foreach ($response["items"] as $items) {
+                $tmp = new Item();
+                $tmp->init($items);
+                $tmp->validate();
+                $this->items[] = $tmp;
+            }
+        }
+        if (isset($response["int"])) {
+            $this->int = $response["int"];
+        }
+        if (isset($response["mode"])) {
+            $this->mode = $response["mode"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->items)) {
+            throw new ProtoApi\GeneralException("'items' is not exist");
+        }
+        if (!isset($this->int)) {
+            throw new ProtoApi\GeneralException("'int' is not exist");
+        }
+        if (!isset($this->mode)) {
+            throw new ProtoApi\GeneralException("'mode' is not exist");
+        }
+    }
+    
+    public function set_items(Items $items)
+    {
+        $this->items = $items;
+    }
+
+    public function get_items()
+    {
+        return $this->items;
+    }
+    
+    public function set_int($int)
+    {
+        $this->int = $int;
+    }
+
+    public function get_int()
+    {
+        return $this->int;
+    }
+    
+    public function set_mode($mode)
+    {
+        $this->mode = $mode;
+    }
+
+    public function get_mode()
+    {
+        return $this->mode;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "items" => $this->items->to_array(),
+            "int" => $this->int,
+            "mode" => $this->mode,
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class Kind extends Enum
+{
+    const UNKNOWN = 0;
+    const PLAIN = 1;
+    const PBclass = 2;
+    const type = 3;
+}
+
+/** Item names the message type too, all the values of the enum are prefixed in go */
+class Mode extends Enum
+{
+    const NONE = 0;
+    const Item = 1;
+}
+
+class Status extends Enum
+{
+    const UNKNOWN = 0;
+    const ACTIVE = 1;
+}
+
+class ItemService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function delete(Item $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Item();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "ItemService.delete", $handler);
+    }
+
+    public function default(Item $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new PBClone();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "ItemService.default", $handler);
+    }
+}
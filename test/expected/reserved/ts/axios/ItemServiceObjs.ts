@@ -0,0 +1,87 @@
+/**
+* This file is generated by 'protoapi'
+* This file contains all the data structure being used in the generated ts services
+* -----------------------------------------------------
+* 该文件生成于protoapi
+* 文件包含API前端调用所引用的数据结构定义
+*/
+
+// enums
+export enum ValidateErrorType {
+    INVALID_EMAIL = 0,
+    FIELD_REQUIRED = 1,
+    OUT_OF_RANGE = 2,
+    INVALID_LENGTH = 3,
+    PATTERN_MISMATCH = 4,
+    INVALID_ITEM_COUNT = 5,
+    UNDEFINED_ENUM_VALUE = 6,
+}
+
+export enum Kind {
+    UNKNOWN = 0,
+    PLAIN = 1,
+    class = 2,
+    type = 3,
+}
+
+/** Item names the message type too, all the values of the enum are prefixed in go */
+export enum Mode {
+    NONE = 0,
+    Item = 1,
+}
+
+export enum Status {
+    UNKNOWN = 0,
+    ACTIVE = 1,
+}
+
+// data types
+export interface CommonError {
+    genericError: GenericError
+    authError: AuthError
+    validateError: ValidateError
+    bindError: BindError
+}
+
+export interface GenericError {
+    message: string
+}
+
+export interface AuthError {
+    message: string
+}
+
+export interface BindError {
+    message: string
+}
+
+export interface ValidateError {
+    errors: FieldError[]
+}
+
+export interface FieldError {
+    fieldName: string
+    errorType: ValidateErrorType
+}
+
+export interface Empty {
+}
+
+export interface Item {
+    type: string
+    class: string
+    default: boolean
+    package: string
+    func: number
+    new: number
+    var: string[]
+    kind: Kind
+    function: string
+    status: Status
+}
+
+export interface Clone {
+    items: Item[]
+    int: number
+    mode: Mode
+}
@@ -0,0 +1,628 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace scalars;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+class Scalars implements ProtoApi\Message
+{
+    protected $d;
+    protected $f;
+    protected $i32;
+    protected $i64;
+    protected $u32;
+    protected $u64;
+    protected $s32;
+    protected $s64;
+    protected $f32;
+    protected $f64;
+    protected $sf32;
+    protected $sf64;
+    protected $b;
+    protected $s;
+    /** bytes are base64 strings in JSON */
+    protected $data;
+    protected $chunks;
+    protected $blobs;
+
+    public function init(array $response)
+    {
+        if (isset($response["d"])) {
+            $this->d = $response["d"];
+        }
+        if (isset($response["f"])) {
+            $this->f = $response["f"];
+        }
+        if (isset($response["i32"])) {
+            $this->i32 = $response["i32"];
+        }
+        if (isset($response["i64"])) {
+            $this->i64 = $response["i64"];
+        }
+        if (isset($response["u32"])) {
+            $this->u32 = $response["u32"];
+        }
+        if (isset($response["u64"])) {
+            $this->u64 = $response["u64"];
+        }
+        if (isset($response["s32"])) {
+            $this->s32 = $response["s32"];
+        }
+        if (isset($response["s64"])) {
+            $this->s64 = $response["s64"];
+        }
+        if (isset($response["f32"])) {
+            $this->f32 = $response["f32"];
+        }
+        if (isset($response["f64"])) {
+            $this->f64 = $response["f64"];
+        }
+        if (isset($response["sf32"])) {
+            $this->sf32 = $response["sf32"];
+        }
+        if (isset($response["sf64"])) {
+            $this->sf64 = $response["sf64"];
+        }
+        if (isset($response["b"])) {
+            $this->b = $response["b"];
+        }
+        if (isset($response["s"])) {
+            $this->s = $response["s"];
+        }
+        if (isset($response["data"])) {
+            $this->data = $response["data"];
+        }
+        if (isset($response["chunks"])) {
+            $this->chunks = array();
+            foreach ($response["chunks"] as $chunks) {
+                $this->chunks[] = $chunks;
+            }
+        }
+        if (isset($response["blobs"])) {
+            $this->blobs = array();
+            foreach ($response["blobs"] as $key => $blobs) {
+                $this->blobs[$key] = $blobs;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->d)) {
+            throw new ProtoApi\GeneralException("'d' is not exist");
+        }
+        if (!isset($this->f)) {
+            throw new ProtoApi\GeneralException("'f' is not exist");
+        }
+        if (!isset($this->i32)) {
+            throw new ProtoApi\GeneralException("'i32' is not exist");
+        }
+        if (!isset($this->i64)) {
+            throw new ProtoApi\GeneralException("'i64' is not exist");
+        }
+        if (!isset($this->u32)) {
+            throw new ProtoApi\GeneralException("'u32' is not exist");
+        }
+        if (!isset($this->u64)) {
+            throw new ProtoApi\GeneralException("'u64' is not exist");
+        }
+        if (!isset($this->s32)) {
+            throw new ProtoApi\GeneralException("'s32' is not exist");
+        }
+        if (!isset($this->s64)) {
+            throw new ProtoApi\GeneralException("'s64' is not exist");
+        }
+        if (!isset($this->f32)) {
+            throw new ProtoApi\GeneralException("'f32' is not exist");
+        }
+        if (!isset($this->f64)) {
+            throw new ProtoApi\GeneralException("'f64' is not exist");
+        }
+        if (!isset($this->sf32)) {
+            throw new ProtoApi\GeneralException("'sf32' is not exist");
+        }
+        if (!isset($this->sf64)) {
+            throw new ProtoApi\GeneralException("'sf64' is not exist");
+        }
+        if (!isset($this->b)) {
+            throw new ProtoApi\GeneralException("'b' is not exist");
+        }
+        if (!isset($this->s)) {
+            throw new ProtoApi\GeneralException("'s' is not exist");
+        }
+        if (!isset($this->data)) {
+            throw new ProtoApi\GeneralException("'data' is not exist");
+        }
+        if (!isset($this->chunks)) {
+            throw new ProtoApi\GeneralException("'chunks' is not exist");
+        }
+        if (!isset($this->blobs)) {
+            throw new ProtoApi\GeneralException("'blobs' is not exist");
+        }
+    }
+    
+    public function set_d($d)
+    {
+        $this->d = $d;
+    }
+
+    public function get_d()
+    {
+        return $this->d;
+    }
+    
+    public function set_f($f)
+    {
+        $this->f = $f;
+    }
+
+    public function get_f()
+    {
+        return $this->f;
+    }
+    
+    public function set_i32($i32)
+    {
+        $this->i32 = $i32;
+    }
+
+    public function get_i32()
+    {
+        return $this->i32;
+    }
+    
+    public function set_i64($i64)
+    {
+        $this->i64 = $i64;
+    }
+
+    public function get_i64()
+    {
+        return $this->i64;
+    }
+    
+    public function set_u32($u32)
+    {
+        $this->u32 = $u32;
+    }
+
+    public function get_u32()
+    {
+        return $this->u32;
+    }
+    
+    public function set_u64($u64)
+    {
+        $this->u64 = $u64;
+    }
+
+    public function get_u64()
+    {
+        return $this->u64;
+    }
+    
+    public function set_s32($s32)
+    {
+        $this->s32 = $s32;
+    }
+
+    public function get_s32()
+    {
+        return $this->s32;
+    }
+    
+    public function set_s64($s64)
+    {
+        $this->s64 = $s64;
+    }
+
+    public function get_s64()
+    {
+        return $this->s64;
+    }
+    
+    public function set_f32($f32)
+    {
+        $this->f32 = $f32;
+    }
+
+    public function get_f32()
+    {
+        return $this->f32;
+    }
+    
+    public function set_f64($f64)
+    {
+        $this->f64 = $f64;
+    }
+
+    public function get_f64()
+    {
+        return $this->f64;
+    }
+    
+    public function set_sf32($sf32)
+    {
+        $this->sf32 = $sf32;
+    }
+
+    public function get_sf32()
+    {
+        return $this->sf32;
+    }
+    
+    public function set_sf64($sf64)
+    {
+        $this->sf64 = $sf64;
+    }
+
+    public function get_sf64()
+    {
+        return $this->sf64;
+    }
+    
+    public function set_b($b)
+    {
+        $this->b = $b;
+    }
+
+    public function get_b()
+    {
+        return $this->b;
+    }
+    
+    public function set_s($s)
+    {
+        $this->s = $s;
+    }
+
+    public function get_s()
+    {
+        return $this->s;
+    }
+    
+    public function set_data($data)
+    {
+        $this->data = $data;
+    }
+
+    public function get_data()
+    {
+        return $this->data;
+    }
+    
+    public function set_chunks($chunks)
+    {
+        $this->chunks = $chunks;
+    }
+
+    public function get_chunks()
+    {
+        return $this->chunks;
+    }
+    
+    public function set_blobs(array $blobs)
+    {
+        $this->blobs = $blobs;
+    }
+
+    public function get_blobs()
+    {
+        return $this->blobs;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "d" => $this->d,
+            "f" => $this->f,
+            "i32" => $this->i32,
+            "i64" => $this->i64,
+            "u32" => $this->u32,
+            "u64" => $this->u64,
+            "s32" => $this->s32,
+            "s64" => $this->s64,
+            "f32" => $this->f32,
+            "f64" => $this->f64,
+            "sf32" => $this->sf32,
+            "sf64" => $this->sf64,
+            "b" => $this->b,
+            "s" => $this->s,
+            "data" => $this->data,
+            "chunks" => $this->chunks,
+            "blobs" => $this->blobs,
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class ScalarService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function echo(Scalars $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Scalars();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "ScalarService.echo", $handler);
+    }
+}
@@ -0,0 +1,16 @@
+<?php
+
+namespace app\modules\services;
+
+use app\modules\services\models;
+
+class AdminServiceRequestHandler extends handlers\AdminServiceRequestHandler{
+    /**
+     * @param models\UserRequest $req
+     * @return models\User
+     */
+    function deleteUser(models\UserRequest $req) {
+        // implement here
+    }
+    
+}
@@ -0,0 +1,53 @@
+<?php
+
+namespace app\modules\services;
+
+use Yii;
+use yii\web\Response;
+use yii\base\BootstrapInterface;
+
+/**
+ * api module definition class
+ */
+class Module extends \yii\base\Module implements BootstrapInterface
+{
+    /**
+     * {@inheritdoc}
+     */
+    public $controllerNamespace = 'app\modules\services\controllers';
+
+    /**
+     * {@inheritdoc}
+     */
+    public function init()
+    {
+        parent::init();
+        Yii::$app->response->format = Response::FORMAT_JSON;
+
+        Yii::$app->setComponents([
+            'request' => [
+                'class' => \yii\web\Request::class,
+                'parsers' => [
+                    'application/json' => 'yii\web\JsonParser',
+                ],
+                'enableCookieValidation' => false,
+                'enableCsrfValidation' => false,
+            ],
+            'errorHandler' => [
+                'class' => 'app\modules\services\handlers\ErrorHandler',
+            ],
+        ]);
+
+        $handler = $this->get('errorHandler');
+        \Yii::$app->set('errorHandler', $handler);
+        $handler->register();
+    }
+
+    public function bootstrap($app)
+    {
+        $app->getUrlManager()->addRules([
+            "POST UserService.getUser" => "UserService/user-service-api/getUser",
+            "POST AdminService.deleteUser" => "UserService/admin-service-api/deleteUser",
+        ], false);
+    }
+}
@@ -0,0 +1,16 @@
+<?php
+
+namespace app\modules\services;
+
+use app\modules\services\models;
+
+class UserServiceRequestHandler extends handlers\UserServiceRequestHandler{
+    /**
+     * @param models\UserRequest $req
+     * @return models\User
+     */
+    function getUser(models\UserRequest $req) {
+        // implement here
+    }
+    
+}
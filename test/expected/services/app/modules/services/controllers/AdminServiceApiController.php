@@ -0,0 +1,47 @@
+<?php
+
+namespace app\modules\services\controllers;
+
+use app\modules\services\models;
+use Yii;
+use yii\web\Controller;
+use Yoozoo\ProtoApi;
+
+class AdminServiceApiController extends Controller
+{
+    private $_handler;
+
+    public function init()
+    {
+        $this->_handler = new \app\modules\services\AdminServiceRequestHandler();
+    }
+
+    /**
+     * {@inheritdoc}
+     */
+    public function behaviors()
+    {
+        $behaviors = parent::behaviors();
+        if (class_exists("\\app\\modules\\services\\AuthHandler")){
+            $behaviors['authenticator'] = [
+                'class' => \app\modules\services\AuthHandler::className(),
+            ];
+        }
+        return $behaviors;
+    }
+    
+    public function actionDeleteUser()
+    {
+        $req = Yii::$app->request;
+        $request = new models\UserRequest();
+        $request->init($req->getBodyParams());
+        $request->validate();
+        $res = $this->_handler->deleteUser($request);
+        if ($res instanceof models\User) {
+            $res->validate();
+            return $res->to_array();
+        }
+        throw new ProtoApi\GeneralException("return type of 'deleteUser' incorrect.");
+    }
+    
+}
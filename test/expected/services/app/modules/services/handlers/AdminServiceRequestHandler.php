@@ -0,0 +1,10 @@
+<?php
+namespace app\modules\services\handlers;
+
+use app\modules\services\models;
+use Yoozoo\ProtoApi;
+
+abstract class AdminServiceRequestHandler
+{
+    abstract public function deleteUser(models\UserRequest $req);
+}
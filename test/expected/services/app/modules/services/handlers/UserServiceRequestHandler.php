@@ -0,0 +1,10 @@
+<?php
+namespace app\modules\services\handlers;
+
+use app\modules\services\models;
+use Yoozoo\ProtoApi;
+
+abstract class UserServiceRequestHandler
+{
+    abstract public function getUser(models\UserRequest $req);
+}
@@ -0,0 +1,121 @@
+<?php
+namespace app\modules\services\models;
+
+use Yoozoo\ProtoApi;
+
+/** the common error of the admin service, with the audit id of the request */
+class AdminError implements ProtoApi\Message
+{
+    protected $genericError;
+    protected $authError;
+    protected $validateError;
+    protected $bindError;
+    protected $auditId;
+
+    public function init(array $response)
+    {
+        if (isset($response["genericError"])) {
+            $this->genericError = new GenericError();
+            $this->genericError->init($response["genericError"]);
+            $this->genericError->validate();
+        }
+        if (isset($response["authError"])) {
+            $this->authError = new AuthError();
+            $this->authError->init($response["authError"]);
+            $this->authError->validate();
+        }
+        if (isset($response["validateError"])) {
+            $this->validateError = new ValidateError();
+            $this->validateError->init($response["validateError"]);
+            $this->validateError->validate();
+        }
+        if (isset($response["bindError"])) {
+            $this->bindError = new BindError();
+            $this->bindError->init($response["bindError"]);
+            $this->bindError->validate();
+        }
+        if (isset($response["auditId"])) {
+            $this->auditId = $response["auditId"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->genericError)) {
+            throw new ProtoApi\GeneralException("'genericError' is not exist");
+        }
+        if (!isset($this->authError)) {
+            throw new ProtoApi\GeneralException("'authError' is not exist");
+        }
+        if (!isset($this->validateError)) {
+            throw new ProtoApi\GeneralException("'validateError' is not exist");
+        }
+        if (!isset($this->bindError)) {
+            throw new ProtoApi\GeneralException("'bindError' is not exist");
+        }
+        if (!isset($this->auditId)) {
+            throw new ProtoApi\GeneralException("'auditId' is not exist");
+        }
+    }
+    
+    public function set_genericError(GenericError $genericError)
+    {
+        $this->genericError = $genericError;
+    }
+
+    public function get_genericError()
+    {
+        return $this->genericError;
+    }
+    
+    public function set_authError(AuthError $authError)
+    {
+        $this->authError = $authError;
+    }
+
+    public function get_authError()
+    {
+        return $this->authError;
+    }
+    
+    public function set_validateError(ValidateError $validateError)
+    {
+        $this->validateError = $validateError;
+    }
+
+    public function get_validateError()
+    {
+        return $this->validateError;
+    }
+    
+    public function set_bindError(BindError $bindError)
+    {
+        $this->bindError = $bindError;
+    }
+
+    public function get_bindError()
+    {
+        return $this->bindError;
+    }
+    
+    public function set_auditId($auditId)
+    {
+        $this->auditId = $auditId;
+    }
+
+    public function get_auditId()
+    {
+        return $this->auditId;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "genericError" => $this->genericError->to_array(),
+            "authError" => $this->authError->to_array(),
+            "validateError" => $this->validateError->to_array(),
+            "bindError" => $this->bindError->to_array(),
+            "auditId" => $this->auditId,
+        );
+    }
+}
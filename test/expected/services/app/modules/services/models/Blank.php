@@ -0,0 +1,22 @@
+<?php
+namespace app\modules\services\models;
+
+use Yoozoo\ProtoApi;
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
@@ -0,0 +1,58 @@
+<?php
+namespace app\modules\services\models;
+
+use Yoozoo\ProtoApi;
+
+class User implements ProtoApi\Message
+{
+    protected $id;
+    protected $name;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+        if (isset($response["name"])) {
+            $this->name = $response["name"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+        if (!isset($this->name)) {
+            throw new ProtoApi\GeneralException("'name' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function set_name($name)
+    {
+        $this->name = $name;
+    }
+
+    public function get_name()
+    {
+        return $this->name;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+            "name" => $this->name,
+        );
+    }
+}
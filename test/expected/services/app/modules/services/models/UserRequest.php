@@ -0,0 +1,40 @@
+<?php
+namespace app\modules\services\models;
+
+use Yoozoo\ProtoApi;
+
+class UserRequest implements ProtoApi\Message
+{
+    protected $id;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+        );
+    }
+}
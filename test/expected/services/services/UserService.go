@@ -0,0 +1,259 @@
+// This is a file generated by protoapi (version.uuzu.com/protoapi)
+// Generated at: 18 Oct 26 07:18 UTC
+// DO NOT EDIT.
+
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+type UserService struct {
+	apiURL string
+}
+
+func (p *UserService) SetApiURL(url string) {
+	p.apiURL = url
+}
+
+type AdminService struct {
+	apiURL string
+}
+
+func (p *AdminService) SetApiURL(url string) {
+	p.apiURL = url
+}
+
+type CommonError struct {
+	GenericError  *GenericError  `json:"genericError"`
+	AuthError     *AuthError     `json:"authError"`
+	ValidateError *ValidateError `json:"validateError"`
+	BindError     *BindError     `json:"bindError"`
+}
+
+func (e *CommonError) Error() string {
+	return "common error"
+}
+
+type GenericError struct {
+	Message string `json:"message"`
+}
+
+func (e *GenericError) Error() string {
+	return "biz error"
+}
+
+type AuthError struct {
+	Message string `json:"message"`
+}
+
+func (e *AuthError) Error() string {
+	return "biz error"
+}
+
+type BindError struct {
+	Message string `json:"message"`
+}
+
+func (e *BindError) Error() string {
+	return "biz error"
+}
+
+type ValidateError struct {
+	Errors []*FieldError `json:"errors"`
+}
+
+func (e *ValidateError) Error() string {
+	return "biz error"
+}
+
+type FieldError struct {
+	FieldName string            `json:"fieldName"`
+	ErrorType ValidateErrorType `json:"errorType"`
+}
+type Empty struct {
+}
+type User struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+}
+type UserRequest struct {
+	Id int32 `json:"id"`
+}
+type UserError struct {
+	Message string `json:"message"`
+}
+
+func (e *UserError) Error() string {
+	return "biz error"
+}
+
+// the common error of the admin service, with the audit id of the request
+type AdminError struct {
+	GenericError  *GenericError  `json:"genericError"`
+	AuthError     *AuthError     `json:"authError"`
+	ValidateError *ValidateError `json:"validateError"`
+	BindError     *BindError     `json:"bindError"`
+	AuditId       string         `json:"auditId"`
+}
+
+func (e *AdminError) Error() string {
+	return "biz error"
+}
+
+type ValidateErrorType int
+
+const (
+	INVALID_EMAIL        ValidateErrorType = 0
+	FIELD_REQUIRED       ValidateErrorType = 1
+	OUT_OF_RANGE         ValidateErrorType = 2
+	INVALID_LENGTH       ValidateErrorType = 3
+	PATTERN_MISMATCH     ValidateErrorType = 4
+	INVALID_ITEM_COUNT   ValidateErrorType = 5
+	UNDEFINED_ENUM_VALUE ValidateErrorType = 6
+)
+
+func (code ValidateErrorType) String() string {
+	names := map[ValidateErrorType]string{
+		INVALID_EMAIL:        "INVALID_EMAIL",
+		FIELD_REQUIRED:       "FIELD_REQUIRED",
+		OUT_OF_RANGE:         "OUT_OF_RANGE",
+		INVALID_LENGTH:       "INVALID_LENGTH",
+		PATTERN_MISMATCH:     "PATTERN_MISMATCH",
+		INVALID_ITEM_COUNT:   "INVALID_ITEM_COUNT",
+		UNDEFINED_ENUM_VALUE: "UNDEFINED_ENUM_VALUE",
+	}
+
+	return names[code]
+}
+
+func (code ValidateErrorType) Code() int {
+	return (int)(code)
+}
+func (code ValidateErrorType) IsINVALID_EMAIL() bool {
+	return code == INVALID_EMAIL
+}
+func (code ValidateErrorType) IsFIELD_REQUIRED() bool {
+	return code == FIELD_REQUIRED
+}
+func (code ValidateErrorType) IsOUT_OF_RANGE() bool {
+	return code == OUT_OF_RANGE
+}
+func (code ValidateErrorType) IsINVALID_LENGTH() bool {
+	return code == INVALID_LENGTH
+}
+func (code ValidateErrorType) IsPATTERN_MISMATCH() bool {
+	return code == PATTERN_MISMATCH
+}
+func (code ValidateErrorType) IsINVALID_ITEM_COUNT() bool {
+	return code == INVALID_ITEM_COUNT
+}
+func (code ValidateErrorType) IsUNDEFINED_ENUM_VALUE() bool {
+	return code == UNDEFINED_ENUM_VALUE
+}
+
+func (p *UserService) GetUser(reqData *UserRequest) (resData *User, err error) {
+	url := p.apiURL + "UserService.getUser"
+	jsonStr, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	jsonByte, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode {
+	case 200:
+		resData := new(User)
+		err = json.Unmarshal(jsonByte, resData)
+		if err != nil {
+			return nil, err
+		}
+		return resData, nil
+	case 400:
+		bizErr := &UserError{}
+		err = json.Unmarshal(jsonByte, bizErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, bizErr
+	case 420:
+		comErr := &CommonError{}
+		err = json.Unmarshal(jsonByte, comErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, comErr
+	case 500:
+		return nil, errors.New("internal server error : " + string(jsonByte))
+	default:
+		return nil, errors.New("unknown status code")
+	}
+}
+
+func (p *AdminService) DeleteUser(reqData *UserRequest) (resData *User, err error) {
+	url := p.apiURL + "AdminService.deleteUser"
+	jsonStr, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	jsonByte, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode {
+	case 200:
+		resData := new(User)
+		err = json.Unmarshal(jsonByte, resData)
+		if err != nil {
+			return nil, err
+		}
+		return resData, nil
+	case 400:
+		bizErr := &UserError{}
+		err = json.Unmarshal(jsonByte, bizErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, bizErr
+	case 420:
+		comErr := &AdminError{}
+		err = json.Unmarshal(jsonByte, comErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, comErr
+	case 500:
+		return nil, errors.New("internal server error : " + string(jsonByte))
+	default:
+		return nil, errors.New("unknown status code")
+	}
+}
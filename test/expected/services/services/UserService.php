@@ -0,0 +1,594 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace services;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+class User implements ProtoApi\Message
+{
+    protected $id;
+    protected $name;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+        if (isset($response["name"])) {
+            $this->name = $response["name"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+        if (!isset($this->name)) {
+            throw new ProtoApi\GeneralException("'name' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function set_name($name)
+    {
+        $this->name = $name;
+    }
+
+    public function get_name()
+    {
+        return $this->name;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+            "name" => $this->name,
+        );
+    }
+}
+
+class UserRequest implements ProtoApi\Message
+{
+    protected $id;
+
+    public function init(array $response)
+    {
+        if (isset($response["id"])) {
+            $this->id = $response["id"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->id)) {
+            throw new ProtoApi\GeneralException("'id' is not exist");
+        }
+    }
+    
+    public function set_id($id)
+    {
+        $this->id = $id;
+    }
+
+    public function get_id()
+    {
+        return $this->id;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "id" => $this->id,
+        );
+    }
+}
+
+class UserError extends ProtoApi\BizErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+/** the common error of the admin service, with the audit id of the request */
+class AdminError implements ProtoApi\Message
+{
+    protected $genericError;
+    protected $authError;
+    protected $validateError;
+    protected $bindError;
+    protected $auditId;
+
+    public function init(array $response)
+    {
+        if (isset($response["genericError"])) {
+            $this->genericError = new GenericError();
+            $this->genericError->init($response["genericError"]);
+            $this->genericError->validate();
+        }
+        if (isset($response["authError"])) {
+            $this->authError = new AuthError();
+            $this->authError->init($response["authError"]);
+            $this->authError->validate();
+        }
+        if (isset($response["validateError"])) {
+            $this->validateError = new ValidateError();
+            $this->validateError->init($response["validateError"]);
+            $this->validateError->validate();
+        }
+        if (isset($response["bindError"])) {
+            $this->bindError = new BindError();
+            $this->bindError->init($response["bindError"]);
+            $this->bindError->validate();
+        }
+        if (isset($response["auditId"])) {
+            $this->auditId = $response["auditId"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->genericError)) {
+            throw new ProtoApi\GeneralException("'genericError' is not exist");
+        }
+        if (!isset($this->authError)) {
+            throw new ProtoApi\GeneralException("'authError' is not exist");
+        }
+        if (!isset($this->validateError)) {
+            throw new ProtoApi\GeneralException("'validateError' is not exist");
+        }
+        if (!isset($this->bindError)) {
+            throw new ProtoApi\GeneralException("'bindError' is not exist");
+        }
+        if (!isset($this->auditId)) {
+            throw new ProtoApi\GeneralException("'auditId' is not exist");
+        }
+    }
+    
+    public function set_genericError(GenericError $genericError)
+    {
+        $this->genericError = $genericError;
+    }
+
+    public function get_genericError()
+    {
+        return $this->genericError;
+    }
+    
+    public function set_authError(AuthError $authError)
+    {
+        $this->authError = $authError;
+    }
+
+    public function get_authError()
+    {
+        return $this->authError;
+    }
+    
+    public function set_validateError(ValidateError $validateError)
+    {
+        $this->validateError = $validateError;
+    }
+
+    public function get_validateError()
+    {
+        return $this->validateError;
+    }
+    
+    public function set_bindError(BindError $bindError)
+    {
+        $this->bindError = $bindError;
+    }
+
+    public function get_bindError()
+    {
+        return $this->bindError;
+    }
+    
+    public function set_auditId($auditId)
+    {
+        $this->auditId = $auditId;
+    }
+
+    public function get_auditId()
+    {
+        return $this->auditId;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "genericError" => $this->genericError->to_array(),
+            "authError" => $this->authError->to_array(),
+            "validateError" => $this->validateError->to_array(),
+            "bindError" => $this->bindError->to_array(),
+            "auditId" => $this->auditId,
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class UserService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function getUser(UserRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new User();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new UserError();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "UserService.getUser", $handler);
+    }
+}
+
+class AdminService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function deleteUser(UserRequest $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new User();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new UserError();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "AdminService.deleteUser", $handler);
+    }
+}
@@ -0,0 +1,136 @@
+/**
+* This file is generated by 'protoapi'
+* The file contains helper functions that would be used in generated api file, usually in './api.ts' or './xxxService.ts'
+* The generated code is written in TypeScript
+* -------------------------------------------
+* 该文件生成于protoapi
+* 文件包含一些函数协助生成的前端调用API
+* 文件内代码使用TypeScript
+*/
+
+import { mapCommonErrorType } from './UserServiceObjs'
+
+/**
+ * Defined Http Code for response handling
+ */
+export enum httpCode {
+    DEFAULT = 0,
+    NORMAL = 200,
+    BIZ_ERROR = 400,
+    COMMON_ERROR = 420,
+    INTERNAL_ERROR = 500,
+}
+/**
+ *
+ * @param {response} response the error response
+ * @param mapCommonError maps the common errors, the ones of the first service by default
+ */
+export function errorHandling(err, mapCommonError: (commonErr: any) => any = mapCommonErrorType): Promise<never> {
+    if(err.response === undefined) {
+        throw err;
+    }
+    let data;
+    try {
+        data = JSON.parse(err.response.data);
+    } catch (err) {
+        data = err.response.data;
+    }
+    switch (err.response.status) {
+        case httpCode.BIZ_ERROR:
+            return Promise.reject(data);
+
+        case httpCode.COMMON_ERROR:
+            let returnErr = mapCommonError(data);
+            return Promise.reject(returnErr);
+
+    }
+    throw data;
+}
+
+/**
+ *
+ * @param val a string
+ * @returns an encoded string that can be append to api url
+ */
+export function encode(val: string): string {
+    return encodeURIComponent(val).
+        replace(/%40/gi, '@').
+        replace(/%3A/gi, ':').
+        replace(/%24/g, '$').
+        replace(/%2C/gi, ',').
+        replace(/%20/g, '+').
+        replace(/%5B/gi, '[').
+        replace(/%5D/gi, ']');
+}
+
+/**
+ * Build a URL by appending params to the end
+ * @param url : the base url for the service
+ * @param params : the request object. e.g. for HelloRequest would be the object of type HelloRequest
+ * @returns: returns a full Url string - for GET by key/value pairs
+ * @example:
+ * baseUrl = "http://localhost:8080"
+ * arg = {name: "wengwei", nick: "wentian"}
+ * returns => http://localhost:8080?name="wengwei"&nick="wentian"
+ */
+export function generateQueryUrl<T>(url: string, params: T): string {
+    if (!params) {
+        return url;
+    }
+
+    let parts: string[] = [];
+
+
+    for (let key in params) {
+        if (!Object.prototype.hasOwnProperty.call(params, key)) {
+            continue;
+        }
+        let val: any = params[key];
+
+        if (val === null || typeof val === 'undefined') {
+            continue;
+        }
+
+        let k, vals;
+        // if is array
+        if (Array.isArray(val)) {
+            k = key + '[]';
+            vals = val;
+        } else {
+            k = key
+            vals = [val];
+        }
+
+        vals.forEach(v => {
+            // if is date
+            if (v instanceof Date) {
+                v = v.toISOString();
+                // if is object
+            } else if (typeof v === 'object') {
+                v = JSON.stringify(v);
+            }
+            parts.push(encode(k) + '=' + encode(v))
+        });
+    }
+    let serializedParams = parts.join('&');
+
+    if (serializedParams) {
+        url += (url.indexOf('?') === -1 ? '?' : '&') + serializedParams;
+    }
+    return url
+}
+
+/**
+ *
+ * @param url the base url for the service
+ * @param serviceName the service name
+ * @param functionName the function name
+ * @example
+ * baseUrl = "http://localhost:8080"
+ * serviceName = "HelloService"
+ * functionName = "SayHello"
+ * returns => http://localhost:8080/HelloService.SayHello
+ */
+export function generateUrl<T>(url: string, serviceName: string, functionName: string): string {
+    return url + "/" + serviceName + "." + functionName;
+}
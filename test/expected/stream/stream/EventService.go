@@ -0,0 +1,434 @@
+// This is a file generated by protoapi (version.uuzu.com/protoapi)
+// Generated at: 18 Oct 26 07:43 UTC
+// DO NOT EDIT.
+
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+type EventService struct {
+	apiURL string
+}
+
+func (p *EventService) SetApiURL(url string) {
+	p.apiURL = url
+}
+
+type CommonError struct {
+	GenericError  *GenericError  `json:"genericError"`
+	AuthError     *AuthError     `json:"authError"`
+	ValidateError *ValidateError `json:"validateError"`
+	BindError     *BindError     `json:"bindError"`
+}
+
+func (e *CommonError) Error() string {
+	return "common error"
+}
+
+type GenericError struct {
+	Message string `json:"message"`
+}
+
+func (e *GenericError) Error() string {
+	return "biz error"
+}
+
+type AuthError struct {
+	Message string `json:"message"`
+}
+
+func (e *AuthError) Error() string {
+	return "biz error"
+}
+
+type BindError struct {
+	Message string `json:"message"`
+}
+
+func (e *BindError) Error() string {
+	return "biz error"
+}
+
+type ValidateError struct {
+	Errors []*FieldError `json:"errors"`
+}
+
+func (e *ValidateError) Error() string {
+	return "biz error"
+}
+
+type FieldError struct {
+	FieldName string            `json:"fieldName"`
+	ErrorType ValidateErrorType `json:"errorType"`
+}
+type Empty struct {
+}
+type WatchRequest struct {
+	Topic string `json:"topic"`
+}
+type Event struct {
+	Topic string `json:"topic"`
+	Seq   int64  `json:"seq"`
+	Text  string `json:"text"`
+}
+type WatchError struct {
+	Message string `json:"message"`
+}
+
+func (e *WatchError) Error() string {
+	return "biz error"
+}
+
+type ValidateErrorType int
+
+const (
+	INVALID_EMAIL        ValidateErrorType = 0
+	FIELD_REQUIRED       ValidateErrorType = 1
+	OUT_OF_RANGE         ValidateErrorType = 2
+	INVALID_LENGTH       ValidateErrorType = 3
+	PATTERN_MISMATCH     ValidateErrorType = 4
+	INVALID_ITEM_COUNT   ValidateErrorType = 5
+	UNDEFINED_ENUM_VALUE ValidateErrorType = 6
+)
+
+func (code ValidateErrorType) String() string {
+	names := map[ValidateErrorType]string{
+		INVALID_EMAIL:        "INVALID_EMAIL",
+		FIELD_REQUIRED:       "FIELD_REQUIRED",
+		OUT_OF_RANGE:         "OUT_OF_RANGE",
+		INVALID_LENGTH:       "INVALID_LENGTH",
+		PATTERN_MISMATCH:     "PATTERN_MISMATCH",
+		INVALID_ITEM_COUNT:   "INVALID_ITEM_COUNT",
+		UNDEFINED_ENUM_VALUE: "UNDEFINED_ENUM_VALUE",
+	}
+
+	return names[code]
+}
+
+func (code ValidateErrorType) Code() int {
+	return (int)(code)
+}
+func (code ValidateErrorType) IsINVALID_EMAIL() bool {
+	return code == INVALID_EMAIL
+}
+func (code ValidateErrorType) IsFIELD_REQUIRED() bool {
+	return code == FIELD_REQUIRED
+}
+func (code ValidateErrorType) IsOUT_OF_RANGE() bool {
+	return code == OUT_OF_RANGE
+}
+func (code ValidateErrorType) IsINVALID_LENGTH() bool {
+	return code == INVALID_LENGTH
+}
+func (code ValidateErrorType) IsPATTERN_MISMATCH() bool {
+	return code == PATTERN_MISMATCH
+}
+func (code ValidateErrorType) IsINVALID_ITEM_COUNT() bool {
+	return code == INVALID_ITEM_COUNT
+}
+func (code ValidateErrorType) IsUNDEFINED_ENUM_VALUE() bool {
+	return code == UNDEFINED_ENUM_VALUE
+}
+
+func (p *EventService) Publish(reqData *Event) (resData *Event, err error) {
+	url := p.apiURL + "EventService.publish"
+	jsonStr, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	jsonByte, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode {
+	case 200:
+		resData := new(Event)
+		err = json.Unmarshal(jsonByte, resData)
+		if err != nil {
+			return nil, err
+		}
+		return resData, nil
+	case 400:
+		bizErr := &WatchError{}
+		err = json.Unmarshal(jsonByte, bizErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, bizErr
+	case 420:
+		comErr := &CommonError{}
+		err = json.Unmarshal(jsonByte, comErr)
+		if err != nil {
+			return nil, err
+		}
+		return nil, comErr
+	case 500:
+		return nil, errors.New("internal server error : " + string(jsonByte))
+	default:
+		return nil, errors.New("unknown status code")
+	}
+}
+
+// sends the events of the topic until the client disconnects
+//
+// Watch streams the responses of the server-streaming method, resData is closed at the end of the stream.
+// The error ending the stream is sent to streamErr, which is closed along with resData.
+// Cancel ctx to stop reading the stream before its end, the error is then ctx.Err().
+func (p *EventService) Watch(ctx context.Context, reqData *WatchRequest) (resData <-chan *Event, streamErr <-chan error, err error) {
+	url := p.apiURL + "EventService.watch"
+	jsonStr, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/event-stream")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	toError := func(status int, jsonByte []byte) error {
+		switch status {
+		case 400:
+			bizErr := &WatchError{}
+			if err := json.Unmarshal(jsonByte, bizErr); err != nil {
+				return err
+			}
+			return bizErr
+		case 420:
+			comErr := &CommonError{}
+			if err := json.Unmarshal(jsonByte, comErr); err != nil {
+				return err
+			}
+			return comErr
+		case 500:
+			return errors.New("internal server error : " + string(jsonByte))
+		default:
+			return errors.New("unknown status code")
+		}
+	}
+	if res.StatusCode != 200 {
+		defer res.Body.Close()
+		jsonByte, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, toError(res.StatusCode, jsonByte)
+	}
+
+	out := make(chan *Event)
+	errs := make(chan error, 1)
+	go func() {
+		defer res.Body.Close()
+		defer close(errs)
+		defer close(out)
+		err := readEvents(res.Body, func(event string, data []byte) error {
+			if event == "error" {
+				return toError(eventError(data))
+			}
+			resData := new(Event)
+			if err := json.Unmarshal(data, resData); err != nil {
+				return err
+			}
+			select {
+			case out <- resData:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			// the body read fails with a transport error once ctx is done
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			errs <- err
+		}
+	}()
+	return out, errs, nil
+}
+
+// Replay streams the responses of the server-streaming method, resData is closed at the end of the stream.
+// The error ending the stream is sent to streamErr, which is closed along with resData.
+// Cancel ctx to stop reading the stream before its end, the error is then ctx.Err().
+func (p *EventService) Replay(ctx context.Context, reqData *WatchRequest) (resData <-chan *Event, streamErr <-chan error, err error) {
+	url := p.apiURL + "topics/" + pathParam(reqData.Topic) + "/events"
+	query, err := queryString(reqData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequest("GET", url+query, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/event-stream")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	toError := func(status int, jsonByte []byte) error {
+		switch status {
+		case 420:
+			comErr := &CommonError{}
+			if err := json.Unmarshal(jsonByte, comErr); err != nil {
+				return err
+			}
+			return comErr
+		case 500:
+			return errors.New("internal server error : " + string(jsonByte))
+		default:
+			return errors.New("unknown status code")
+		}
+	}
+	if res.StatusCode != 200 {
+		defer res.Body.Close()
+		jsonByte, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, toError(res.StatusCode, jsonByte)
+	}
+
+	out := make(chan *Event)
+	errs := make(chan error, 1)
+	go func() {
+		defer res.Body.Close()
+		defer close(errs)
+		defer close(out)
+		err := readEvents(res.Body, func(event string, data []byte) error {
+			if event == "error" {
+				return toError(eventError(data))
+			}
+			resData := new(Event)
+			if err := json.Unmarshal(data, resData); err != nil {
+				return err
+			}
+			select {
+			case out <- resData:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			// the body read fails with a transport error once ctx is done
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			errs <- err
+		}
+	}()
+	return out, errs, nil
+}
+
+// pathParam formats a path parameter of the request url
+func pathParam(v interface{}) string {
+	return url.PathEscape(fmt.Sprint(v))
+}
+
+// queryString encodes the request as the query string of the url, messages and lists are given in JSON
+func queryString(reqData interface{}) (string, error) {
+	jsonStr, err := json.Marshal(reqData)
+	if err != nil {
+		return "", err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(jsonStr, &fields); err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	for key, value := range fields {
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			s = string(value)
+		}
+		query.Set(key, s)
+	}
+	if len(query) == 0 {
+		return "", nil
+	}
+	return "?" + query.Encode(), nil
+}
+
+// readEvents reads the Server-Sent Events of a streamed response, handle is called with the name and the data of every event
+func readEvents(body io.Reader, handle func(event string, data []byte) error) error {
+	reader := bufio.NewReader(body)
+	var event string
+	var data []byte
+	hasData := false
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		line = bytes.TrimRight(line, "\r\n")
+		switch {
+		case len(line) == 0:
+			// a blank line ends the event, the events without data are not dispatched
+			if hasData {
+				if err := handle(event, data); err != nil {
+					return err
+				}
+			}
+			event, data, hasData = "", nil, false
+		case bytes.HasPrefix(line, []byte("data:")):
+			if hasData {
+				data = append(data, '\n')
+			}
+			data = append(data, bytes.TrimPrefix(line[len("data:"):], []byte(" "))...)
+			hasData = true
+		case bytes.HasPrefix(line, []byte("event:")):
+			event = string(bytes.TrimSpace(line[len("event:"):]))
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// eventError returns the status code and the body of an error event, text bodies are sent as JSON strings
+func eventError(data []byte) (int, []byte) {
+	var e struct {
+		Status int             `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		return 500, data
+	}
+	var text string
+	if json.Unmarshal(e.Error, &text) == nil {
+		return e.Status, []byte(text)
+	}
+	return e.Status, e.Error
+}
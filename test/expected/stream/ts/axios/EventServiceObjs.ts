@@ -0,0 +1,89 @@
+/**
+* This file is generated by 'protoapi'
+* This file contains all the data structure being used in the generated ts services
+* -----------------------------------------------------
+* 该文件生成于protoapi
+* 文件包含API前端调用所引用的数据结构定义
+*/
+
+// enums
+export enum ValidateErrorType {
+    INVALID_EMAIL = 0,
+    FIELD_REQUIRED = 1,
+    OUT_OF_RANGE = 2,
+    INVALID_LENGTH = 3,
+    PATTERN_MISMATCH = 4,
+    INVALID_ITEM_COUNT = 5,
+    UNDEFINED_ENUM_VALUE = 6,
+}
+
+// data types
+export interface CommonError {
+    genericError: GenericError
+    authError: AuthError
+    validateError: ValidateError
+    bindError: BindError
+}
+
+export interface GenericError {
+    message: string
+}
+
+export interface AuthError {
+    message: string
+}
+
+export interface BindError {
+    message: string
+}
+
+export interface ValidateError {
+    errors: FieldError[]
+}
+
+export interface FieldError {
+    fieldName: string
+    errorType: ValidateErrorType
+}
+
+export interface Empty {
+}
+
+export interface WatchRequest {
+    topic: string
+}
+
+export interface Event {
+    topic: string
+    seq: number
+    text: string
+}
+
+export interface WatchError {
+    message: string
+}
+
+/**
+ *
+ * @param {CommonError} commonErr the error object
+ */
+export function mapCommonErrorType(commonErr: CommonError): (string | GenericError | AuthError | ValidateError | BindError) {
+    for (let key in commonErr) {
+        if (commonErr.hasOwnProperty(key) && commonErr[key]) {
+            switch (key) {
+                case 'genericError':
+                    return commonErr[key] as GenericError
+                case 'authError':
+                    return commonErr[key] as AuthError
+                case 'validateError':
+                    return commonErr[key] as ValidateError
+                case 'bindError':
+                    return commonErr[key] as BindError
+                default:
+                    return "Unknown Error"
+            }
+
+        }
+    }
+    return "Unknown Error"
+}
@@ -0,0 +1,206 @@
+/**
+* This file is generated by 'protoapi'
+* The file contains helper functions that would be used in generated api file, usually in './api.ts' or './xxxService.ts'
+* The generated code is written in TypeScript
+* -------------------------------------------
+* 该文件生成于protoapi
+* 文件包含一些函数协助生成的前端调用API
+* 文件内代码使用TypeScript
+*/
+
+import { mapCommonErrorType } from './EventServiceObjs'
+
+/**
+ * Defined Http Code for response handling
+ */
+export enum httpCode {
+    DEFAULT = 0,
+    NORMAL = 200,
+    BIZ_ERROR = 400,
+    COMMON_ERROR = 420,
+    INTERNAL_ERROR = 500,
+}
+/**
+ *
+ * @param {response} response the error response
+ * @param mapCommonError maps the common errors, the ones of the first service by default
+ */
+export function errorHandling(err, mapCommonError: (commonErr: any) => any = mapCommonErrorType): Promise<never> {
+    if(err.response === undefined) {
+        throw err;
+    }
+    let data;
+    try {
+        data = JSON.parse(err.response.data);
+    } catch (err) {
+        data = err.response.data;
+    }
+    switch (err.response.status) {
+        case httpCode.BIZ_ERROR:
+            return Promise.reject(data);
+
+        case httpCode.COMMON_ERROR:
+            let returnErr = mapCommonError(data);
+            return Promise.reject(returnErr);
+
+    }
+    throw data;
+}
+
+/**
+ *
+ * @param val a string
+ * @returns an encoded string that can be append to api url
+ */
+export function encode(val: string): string {
+    return encodeURIComponent(val).
+        replace(/%40/gi, '@').
+        replace(/%3A/gi, ':').
+        replace(/%24/g, '$').
+        replace(/%2C/gi, ',').
+        replace(/%20/g, '+').
+        replace(/%5B/gi, '[').
+        replace(/%5D/gi, ']');
+}
+
+/**
+ * Build a URL by appending params to the end
+ * @param url : the base url for the service
+ * @param params : the request object. e.g. for HelloRequest would be the object of type HelloRequest
+ * @returns: returns a full Url string - for GET by key/value pairs
+ * @example:
+ * baseUrl = "http://localhost:8080"
+ * arg = {name: "wengwei", nick: "wentian"}
+ * returns => http://localhost:8080?name="wengwei"&nick="wentian"
+ */
+export function generateQueryUrl<T>(url: string, params: T): string {
+    if (!params) {
+        return url;
+    }
+
+    let parts: string[] = [];
+
+
+    for (let key in params) {
+        if (!Object.prototype.hasOwnProperty.call(params, key)) {
+            continue;
+        }
+        let val: any = params[key];
+
+        if (val === null || typeof val === 'undefined') {
+            continue;
+        }
+
+        let k, vals;
+        // if is array
+        if (Array.isArray(val)) {
+            k = key + '[]';
+            vals = val;
+        } else {
+            k = key
+            vals = [val];
+        }
+
+        vals.forEach(v => {
+            // if is date
+            if (v instanceof Date) {
+                v = v.toISOString();
+                // if is object
+            } else if (typeof v === 'object') {
+                v = JSON.stringify(v);
+            }
+            parts.push(encode(k) + '=' + encode(v))
+        });
+    }
+    let serializedParams = parts.join('&');
+
+    if (serializedParams) {
+        url += (url.indexOf('?') === -1 ? '?' : '&') + serializedParams;
+    }
+    return url
+}
+
+/**
+ *
+ * @param url the base url for the service
+ * @param serviceName the service name
+ * @param functionName the function name
+ * @example
+ * baseUrl = "http://localhost:8080"
+ * serviceName = "HelloService"
+ * functionName = "SayHello"
+ * returns => http://localhost:8080/HelloService.SayHello
+ */
+export function generateUrl<T>(url: string, serviceName: string, functionName: string): string {
+    return url + "/" + serviceName + "." + functionName;
+}
+
+/**
+ * Call a server-streaming method, which answers with Server-Sent Events
+ * fetch is used by all the clients since axios cannot read a streamed response in the browsers
+ * @param url the url of the method
+ * @param params the request object, sent in the query string by GET, HEAD and DELETE requests and in the JSON body by the others
+ * @param httpMethod the HTTP verb of the method
+ * @param mapCommonError maps the common errors, see errorHandling
+ * @returns an async iterator of the responses, the errors are thrown like errorHandling does
+ */
+export async function* streamCall<InType, OutType>(url: string, params: InType, httpMethod: string, mapCommonError?: (commonErr: any) => any): AsyncIterableIterator<OutType> {
+    let headers: { [key: string]: string } = { 'Accept': 'text/event-stream' };
+    let init: RequestInit = { method: httpMethod, headers: headers };
+    if (httpMethod === 'GET' || httpMethod === 'HEAD' || httpMethod === 'DELETE') {
+        url = generateQueryUrl(url, params);
+    } else {
+        headers['Content-Type'] = 'application/json';
+        init.body = JSON.stringify(params);
+    }
+
+    let res = await fetch(url, init);
+    if (!res.ok || !res.body) {
+        let text = await res.text();
+        let data;
+        try {
+            data = JSON.parse(text);
+        } catch (e) {
+            data = text;
+        }
+        await streamError(res.status, data, mapCommonError);
+    }
+
+    let reader = res.body!.getReader();
+    let decoder = new TextDecoder();
+    let buffer = '';
+    let event = '';
+    let data: string[] = [];
+    while (true) {
+        let { done, value } = await reader.read();
+        if (done) {
+            return;
+        }
+        buffer += decoder.decode(value, { stream: true });
+        let pos: number;
+        while ((pos = buffer.indexOf('\n')) >= 0) {
+            let line = buffer.slice(0, pos).replace(/\r$/, '');
+            buffer = buffer.slice(pos + 1);
+            if (line === '') {
+                // a blank line ends the event
+                if (data.length > 0) {
+                    let payload = JSON.parse(data.join('\n'));
+                    if (event === 'error') {
+                        await streamError(payload.status, payload.error, mapCommonError);
+                    }
+                    yield payload;
+                }
+                event = '';
+                data = [];
+            } else if (line.indexOf('data:') === 0) {
+                data.push(line.slice(5).replace(/^ /, ''));
+            } else if (line.indexOf('event:') === 0) {
+                event = line.slice(6).trim();
+            }
+        }
+    }
+}
+
+function streamError(status: number, data: any, mapCommonError?: (commonErr: any) => any): Promise<never> {
+    return errorHandling({ response: { status: status, data: JSON.stringify(data) } }, mapCommonError);
+}
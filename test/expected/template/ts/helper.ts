@@ -0,0 +1,3 @@
+// helper.ts replaced by a user template
+export const ADD_PATH = "CalcService.add";
+export const AddInput = "Request";
@@ -37,7 +37,7 @@ import {
     UploadProtoFileResponse,
     
 } from './AppServiceObjs';
-import { generateUrl, errorHandling } from './helper';
+import { errorHandling } from './helper';
 
 var baseUrl = "http://192.168.115.60:8080";
 
@@ -45,8 +45,9 @@ export function SetBaseUrl(url: string) {
     baseUrl = url;
 }
 // use axios
+/** get env */
 export function getEnv(params: EnvListRequest): Promise<EnvListResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "getEnv");
+    let url: string = baseUrl + "/AppService.getEnv";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -73,8 +74,9 @@ export function getEnv(params: EnvListRequest): Promise<EnvListResponse | never>
         });
 }
 
+/** register a service */
 export function registerService(params: RegisterServiceRequest): Promise<RegisterServiceResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "registerService");
+    let url: string = baseUrl + "/AppService.registerService";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -101,8 +103,9 @@ export function registerService(params: RegisterServiceRequest): Promise<Registe
         });
 }
 
+/** update a service */
 export function updateService(params: UpdateServiceRequest): Promise<UpdateServiceResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "updateService");
+    let url: string = baseUrl + "/AppService.updateService";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -129,8 +132,9 @@ export function updateService(params: UpdateServiceRequest): Promise<UpdateServi
         });
 }
 
+/** upload proto file */
 export function uploadProtoFile(params: UploadProtoFileRequest): Promise<UploadProtoFileResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "uploadProtoFile");
+    let url: string = baseUrl + "/AppService.uploadProtoFile";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -157,8 +161,9 @@ export function uploadProtoFile(params: UploadProtoFileRequest): Promise<UploadP
         });
 }
 
+/** get a list of tags that contains apps */
 export function getTags(params: TagListRequest): Promise<TagListResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "getTags");
+    let url: string = baseUrl + "/AppService.getTags";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -185,8 +190,9 @@ export function getTags(params: TagListRequest): Promise<TagListResponse | never
         });
 }
 
+/** get a list of apps under specified tag */
 export function getProducts(params: ProductListRequest): Promise<ProductListResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "getProducts");
+    let url: string = baseUrl + "/AppService.getProducts";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -213,8 +219,9 @@ export function getProducts(params: ProductListRequest): Promise<ProductListResp
         });
 }
 
+/** get a list of services under specified tag */
 export function getServices(params: ServiceListRequest): Promise<ServiceListResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "getServices");
+    let url: string = baseUrl + "/AppService.getServices";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -241,8 +248,9 @@ export function getServices(params: ServiceListRequest): Promise<ServiceListResp
         });
 }
 
+/** search services */
 export function searchServices(params: ServiceSearchRequest): Promise<ServiceListResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "searchServices");
+    let url: string = baseUrl + "/AppService.searchServices";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -269,8 +277,9 @@ export function searchServices(params: ServiceSearchRequest): Promise<ServiceLis
         });
 }
 
+/** get a list of keys of the specified service */
 export function getKeyList(params: KeyListRequest): Promise<KeyListResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "getKeyList");
+    let url: string = baseUrl + "/AppService.getKeyList";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -297,8 +306,9 @@ export function getKeyList(params: KeyListRequest): Promise<KeyListResponse | ne
         });
 }
 
+/** get key-value pairs given the list of keys */
 export function getKeyValueList(params: KeyValueListRequest): Promise<KeyValueListResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "getKeyValueList");
+    let url: string = baseUrl + "/AppService.getKeyValueList";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -325,8 +335,9 @@ export function getKeyValueList(params: KeyValueListRequest): Promise<KeyValueLi
         });
 }
 
+/** search key value list by key or value */
 export function searchKeyValueList(params: SearchKeyValueListRequest): Promise<KeyValueListResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "searchKeyValueList");
+    let url: string = baseUrl + "/AppService.searchKeyValueList";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -353,8 +364,9 @@ export function searchKeyValueList(params: SearchKeyValueListRequest): Promise<K
         });
 }
 
+/** update value by key */
 export function updateKeyValue(params: KeyValueRequest): Promise<KeyValueResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "updateKeyValue");
+    let url: string = baseUrl + "/AppService.updateKeyValue";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
@@ -381,8 +393,9 @@ export function updateKeyValue(params: KeyValueRequest): Promise<KeyValueRespons
         });
 }
 
+/** fetch key's value change history */
 export function fetchKeyHistory(params: KVHistoryRequest): Promise<KVHistoryResponse | never> {
-    let url: string = generateUrl(baseUrl, "AppService", "fetchKeyHistory");
+    let url: string = baseUrl + "/AppService.fetchKeyHistory";
     var config = {
         "transformResponse" : [function transformResponse(data) {
             return data;
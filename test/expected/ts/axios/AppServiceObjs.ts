@@ -10,6 +10,11 @@
 export enum ValidateErrorType {
     INVALID_EMAIL = 0,
     FIELD_REQUIRED = 1,
+    OUT_OF_RANGE = 2,
+    INVALID_LENGTH = 3,
+    PATTERN_MISMATCH = 4,
+    INVALID_ITEM_COUNT = 5,
+    UNDEFINED_ENUM_VALUE = 6,
 }
 
 export enum ErrorCode {
@@ -52,6 +57,7 @@ export interface FieldError {
 export interface Empty {
 }
 
+/** env list - 环境 */
 export interface EnvListRequest {
 }
 
@@ -59,6 +65,7 @@ export interface EnvListResponse {
     envs: Env[]
 }
 
+/** tag list - 用于分类 */
 export interface TagListRequest {
 }
 
@@ -66,7 +73,9 @@ export interface TagListResponse {
     tags: Tag[]
 }
 
+/** product list */
 export interface ProductListRequest {
+    /** DEV, UAT, PROD etc */
     env_id: number
 }
 
@@ -74,14 +83,21 @@ export interface ProductListResponse {
     products: Product[]
 }
 
+/** service list */
 export interface ServiceListRequest {
+    /** optional, for filter */
     tag_ids: number[]
     env_id: number
     offset: number
     limit: number
 }
 
+/** service list */
 export interface ServiceSearchRequest {
+    /**
+     * optional, for filter
+     * string prefix = 2 [ (val_required) = true, (val_format) = "email" ];
+     */
     tag_ids: number[]
     prefix: string
     env_id: number
@@ -96,6 +112,11 @@ export interface ServiceListResponse {
     total: number
 }
 
+/**
+ * key list
+ * PS: separate key list & key value because proto and kv store may be on
+ * different endpoint
+ */
 export interface KeyListRequest {
     service_id: number
     env_id: number
@@ -105,6 +126,7 @@ export interface KeyListResponse {
     keys: Key[]
 }
 
+/** key value */
 export interface KeyValueListRequest {
     service_id: number
     keys: Key[]
@@ -177,11 +199,13 @@ export interface UploadProtoFileResponse {
     key_count: number
 }
 
+/** other building blocks */
 export interface Env {
     env_id: number
     env_name: string
 }
 
+/** 用于分类 */
 export interface Tag {
     tag_id: number
     tag_name: string
@@ -36,70 +36,89 @@ import {
     UploadProtoFileResponse,
     
 } from './AppServiceObjs';
-import { generateUrl, errorHandling } from './helper';
+import { generateQueryUrl, errorHandling } from './helper';
 
 var baseUrl = "http://192.168.115.60:8080";
 
 export function SetBaseUrl(url: string) {
     baseUrl = url;
 }// use fetch
-function call<InType, OutType>(service: string, method: string, params: InType): Promise<OutType | never> {
-    let url: string = generateUrl(baseUrl, service, method);
+// GET, HEAD and DELETE requests send the params in the query string, the others in the JSON body
+function call<InType, OutType>(url: string, params: InType, httpMethod: string): Promise<OutType | never> {
+    let init: RequestInit = { method: httpMethod };
+    if (httpMethod === 'GET' || httpMethod === 'HEAD' || httpMethod === 'DELETE') {
+        url = generateQueryUrl(url, params);
+    } else {
+        init.body = JSON.stringify(params);
+    }
 
-    return fetch(url, { method: 'POST', body: JSON.stringify(params) }).then(res => {
+    return fetch(url, init).then(res => {
         return Promise.resolve(res.json())
     }).catch(err => {
         return errorHandling(err)
     });
 }
+/** get env */
 export function getEnv(params: EnvListRequest): Promise<EnvListResponse | never> {
-    return call<EnvListRequest, EnvListResponse>("AppService", "getEnv", params);
+    return call<EnvListRequest, EnvListResponse>(baseUrl + "/AppService.getEnv", params, "POST");
 }
 
+/** register a service */
 export function registerService(params: RegisterServiceRequest): Promise<RegisterServiceResponse | never> {
-    return call<RegisterServiceRequest, RegisterServiceResponse>("AppService", "registerService", params);
+    return call<RegisterServiceRequest, RegisterServiceResponse>(baseUrl + "/AppService.registerService", params, "POST");
 }
 
+/** update a service */
 export function updateService(params: UpdateServiceRequest): Promise<UpdateServiceResponse | never> {
-    return call<UpdateServiceRequest, UpdateServiceResponse>("AppService", "updateService", params);
+    return call<UpdateServiceRequest, UpdateServiceResponse>(baseUrl + "/AppService.updateService", params, "POST");
 }
 
+/** upload proto file */
 export function uploadProtoFile(params: UploadProtoFileRequest): Promise<UploadProtoFileResponse | never> {
-    return call<UploadProtoFileRequest, UploadProtoFileResponse>("AppService", "uploadProtoFile", params);
+    return call<UploadProtoFileRequest, UploadProtoFileResponse>(baseUrl + "/AppService.uploadProtoFile", params, "POST");
 }
 
+/** get a list of tags that contains apps */
 export function getTags(params: TagListRequest): Promise<TagListResponse | never> {
-    return call<TagListRequest, TagListResponse>("AppService", "getTags", params);
+    return call<TagListRequest, TagListResponse>(baseUrl + "/AppService.getTags", params, "POST");
 }
 
+/** get a list of apps under specified tag */
 export function getProducts(params: ProductListRequest): Promise<ProductListResponse | never> {
-    return call<ProductListRequest, ProductListResponse>("AppService", "getProducts", params);
+    return call<ProductListRequest, ProductListResponse>(baseUrl + "/AppService.getProducts", params, "POST");
 }
 
+/** get a list of services under specified tag */
 export function getServices(params: ServiceListRequest): Promise<ServiceListResponse | never> {
-    return call<ServiceListRequest, ServiceListResponse>("AppService", "getServices", params);
+    return call<ServiceListRequest, ServiceListResponse>(baseUrl + "/AppService.getServices", params, "POST");
 }
 
+/** search services */
 export function searchServices(params: ServiceSearchRequest): Promise<ServiceListResponse | never> {
-    return call<ServiceSearchRequest, ServiceListResponse>("AppService", "searchServices", params);
+    return call<ServiceSearchRequest, ServiceListResponse>(baseUrl + "/AppService.searchServices", params, "POST");
 }
 
+/** get a list of keys of the specified service */
 export function getKeyList(params: KeyListRequest): Promise<KeyListResponse | never> {
-    return call<KeyListRequest, KeyListResponse>("AppService", "getKeyList", params);
+    return call<KeyListRequest, KeyListResponse>(baseUrl + "/AppService.getKeyList", params, "POST");
 }
 
+/** get key-value pairs given the list of keys */
 export function getKeyValueList(params: KeyValueListRequest): Promise<KeyValueListResponse | never> {
-    return call<KeyValueListRequest, KeyValueListResponse>("AppService", "getKeyValueList", params);
+    return call<KeyValueListRequest, KeyValueListResponse>(baseUrl + "/AppService.getKeyValueList", params, "POST");
 }
 
+/** search key value list by key or value */
 export function searchKeyValueList(params: SearchKeyValueListRequest): Promise<KeyValueListResponse | never> {
-    return call<SearchKeyValueListRequest, KeyValueListResponse>("AppService", "searchKeyValueList", params);
+    return call<SearchKeyValueListRequest, KeyValueListResponse>(baseUrl + "/AppService.searchKeyValueList", params, "POST");
 }
 
+/** update value by key */
 export function updateKeyValue(params: KeyValueRequest): Promise<KeyValueResponse | never> {
-    return call<KeyValueRequest, KeyValueResponse>("AppService", "updateKeyValue", params);
+    return call<KeyValueRequest, KeyValueResponse>(baseUrl + "/AppService.updateKeyValue", params, "POST");
 }
 
+/** fetch key's value change history */
 export function fetchKeyHistory(params: KVHistoryRequest): Promise<KVHistoryResponse | never> {
-    return call<KVHistoryRequest, KVHistoryResponse>("AppService", "fetchKeyHistory", params);
+    return call<KVHistoryRequest, KVHistoryResponse>(baseUrl + "/AppService.fetchKeyHistory", params, "POST");
 }
@@ -23,8 +23,9 @@ export enum httpCode {
 /**
  *
  * @param {response} response the error response
+ * @param mapCommonError maps the common errors, the ones of the first service by default
  */
-export function errorHandling(err): Promise<never> {
+export function errorHandling(err, mapCommonError: (commonErr: any) => any = mapCommonErrorType): Promise<never> {
     if(err.response === undefined) {
         throw err;
     }
@@ -39,7 +40,7 @@ export function errorHandling(err): Promise<never> {
             return Promise.reject(data);
 
         case httpCode.COMMON_ERROR:
-            let returnErr = mapCommonErrorType(data);
+            let returnErr = mapCommonError(data);
             return Promise.reject(returnErr);
 
     }
@@ -81,19 +82,20 @@ export function generateQueryUrl<T>(url: string, params: T): string {
 
 
     for (let key in params) {
-        let val;
-        if (Object.prototype.hasOwnProperty(key)) {
-            val = params[key];
+        if (!Object.prototype.hasOwnProperty.call(params, key)) {
+            continue;
         }
+        let val: any = params[key];
 
         if (val === null || typeof val === 'undefined') {
-            return '';
+            continue;
         }
 
         let k, vals;
         // if is array
-        if (val.toString() === '[object Array]') {
+        if (Array.isArray(val)) {
             k = key + '[]';
+            vals = val;
         } else {
             k = key
             vals = [val];
@@ -101,7 +103,7 @@ export function generateQueryUrl<T>(url: string, params: T): string {
 
         vals.forEach(v => {
             // if is date
-            if (v.toString() === '[object File]') {
+            if (v instanceof Date) {
                 v = v.toISOString();
                 // if is object
             } else if (typeof v === 'object') {
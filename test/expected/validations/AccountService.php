@@ -0,0 +1,596 @@
+<?php
+// This is a file generated by protoapi:phpclient (version.uuzu.com/protoapi)
+// DO NOT EDIT.
+
+namespace validations;
+
+use Yoozoo\ProtoApi;
+use MyCLabs\Enum\Enum;
+
+/** Messages **/
+class GenericError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class AuthError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class BindError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $message;
+
+    public function init(array $response)
+    {
+        if (isset($response["message"])) {
+            $this->message = $response["message"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->message)) {
+            throw new ProtoApi\GeneralException("'message' is not exist");
+        }
+    }
+    
+    public function set_message($message)
+    {
+        $this->message = $message;
+    }
+
+    public function get_message()
+    {
+        return $this->message;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "message" => $this->message,
+        );
+    }
+}
+
+class ValidateError extends ProtoApi\CommonErrorException implements ProtoApi\Message
+{
+    protected $errors;
+
+    public function init(array $response)
+    {
+        if (isset($response["errors"])) {
+            $this->errors = array();
+            foreach ($response["errors"] as $errors) {
+                $tmp = new FieldError();
+                $tmp->init($errors);
+                $tmp->validate();
+                $this->errors[] = $tmp;
+            }
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->errors)) {
+            throw new ProtoApi\GeneralException("'errors' is not exist");
+        }
+    }
+    
+    public function set_errors(Errors $errors)
+    {
+        $this->errors = $errors;
+    }
+
+    public function get_errors()
+    {
+        return $this->errors;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "errors" => $this->errors->to_array(),
+        );
+    }
+}
+
+class FieldError implements ProtoApi\Message
+{
+    protected $fieldName;
+    protected $errorType;
+
+    public function init(array $response)
+    {
+        if (isset($response["fieldName"])) {
+            $this->fieldName = $response["fieldName"];
+        }
+        if (isset($response["errorType"])) {
+            $this->errorType = $response["errorType"];
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->fieldName)) {
+            throw new ProtoApi\GeneralException("'fieldName' is not exist");
+        }
+        if (!isset($this->errorType)) {
+            throw new ProtoApi\GeneralException("'errorType' is not exist");
+        }
+    }
+    
+    public function set_fieldName($fieldName)
+    {
+        $this->fieldName = $fieldName;
+    }
+
+    public function get_fieldName()
+    {
+        return $this->fieldName;
+    }
+    
+    public function set_errorType($errorType)
+    {
+        $this->errorType = $errorType;
+    }
+
+    public function get_errorType()
+    {
+        return $this->errorType;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "fieldName" => $this->fieldName,
+            "errorType" => $this->errorType,
+        );
+    }
+}
+
+class Blank implements ProtoApi\Message
+{
+
+    public function init(array $response)
+    {
+    }
+
+    public function validate()
+    {
+    }
+    
+    public function to_array()
+    {
+        return array(
+        );
+    }
+}
+
+class Account implements ProtoApi\Message
+{
+    protected $name;
+    protected $email;
+    protected $code;
+    protected $age;
+    protected $score;
+    protected $role;
+    protected $tags;
+    protected $quotas;
+    protected $avatar;
+    protected $manager;
+
+    public function init(array $response)
+    {
+        if (isset($response["name"])) {
+            $this->name = $response["name"];
+        }
+        if (isset($response["email"])) {
+            $this->email = $response["email"];
+        }
+        if (isset($response["code"])) {
+            $this->code = $response["code"];
+        }
+        if (isset($response["age"])) {
+            $this->age = $response["age"];
+        }
+        if (isset($response["score"])) {
+            $this->score = $response["score"];
+        }
+        if (isset($response["role"])) {
+            $this->role = $response["role"];
+        }
+        if (isset($response["tags"])) {
+            $this->tags = array();
+            foreach ($response["tags"] as $tags) {
+                $this->tags[] = $tags;
+            }
+        }
+        if (isset($response["quotas"])) {
+            $this->quotas = array();
+            foreach ($response["quotas"] as $key => $quotas) {
+                $this->quotas[$key] = $quotas;
+            }
+        }
+        if (isset($response["avatar"])) {
+            $this->avatar = $response["avatar"];
+        }
+        if (isset($response["manager"])) {
+            $this->manager = new Account();
+            $this->manager->init($response["manager"]);
+            $this->manager->validate();
+        }
+    }
+
+    public function validate()
+    {
+        if (!isset($this->name)) {
+            throw new ProtoApi\GeneralException("'name' is not exist");
+        }
+        if (empty($this->name)) {
+            throw new ProtoApi\GeneralException("'name' is required");
+        }
+        if (isset($this->name)) {
+            foreach (array($this->name) as $value) {
+                $length = mb_strlen($value, "UTF-8");
+                if ($length < 2) {
+                    throw new ProtoApi\GeneralException("'name' is shorter than 2");
+                }
+                if ($length > 32) {
+                    throw new ProtoApi\GeneralException("'name' is longer than 32");
+                }
+            }
+        }
+        if (!isset($this->email)) {
+            throw new ProtoApi\GeneralException("'email' is not exist");
+        }
+        if (isset($this->email)) {
+            foreach (array($this->email) as $value) {
+                if (filter_var($value, FILTER_VALIDATE_EMAIL) === false) {
+                    throw new ProtoApi\GeneralException("'email' is not an email address");
+                }
+            }
+        }
+        if (!isset($this->code)) {
+            throw new ProtoApi\GeneralException("'code' is not exist");
+        }
+        if (isset($this->code)) {
+            foreach (array($this->code) as $value) {
+                if (!preg_match('~^[A-Z]{3}-\\d+$~u', $value)) {
+                    throw new ProtoApi\GeneralException("'code' does not match the pattern");
+                }
+            }
+        }
+        if (!isset($this->age)) {
+            throw new ProtoApi\GeneralException("'age' is not exist");
+        }
+        if (isset($this->age)) {
+            foreach (array($this->age) as $value) {
+                if ($value < 18) {
+                    throw new ProtoApi\GeneralException("'age' is less than 18");
+                }
+                if ($value > 150) {
+                    throw new ProtoApi\GeneralException("'age' is greater than 150");
+                }
+            }
+        }
+        if (isset($this->score)) {
+            foreach (array($this->score) as $value) {
+                if ($value < 0) {
+                    throw new ProtoApi\GeneralException("'score' is less than 0");
+                }
+                if ($value > 100) {
+                    throw new ProtoApi\GeneralException("'score' is greater than 100");
+                }
+            }
+        }
+        if (!isset($this->role)) {
+            throw new ProtoApi\GeneralException("'role' is not exist");
+        }
+        if (isset($this->role)) {
+            foreach (array($this->role) as $value) {
+                if (!Role::isValid($value)) {
+                    throw new ProtoApi\GeneralException("'role' is not a valid Role");
+                }
+            }
+        }
+        if (!isset($this->tags)) {
+            throw new ProtoApi\GeneralException("'tags' is not exist");
+        }
+        $count = isset($this->tags) ? count($this->tags) : 0;
+        if ($count < 1) {
+            throw new ProtoApi\GeneralException("'tags' has less than 1 items");
+        }
+        if ($count > 5) {
+            throw new ProtoApi\GeneralException("'tags' has more than 5 items");
+        }
+        if (isset($this->tags)) {
+            foreach ($this->tags as $value) {
+                $length = mb_strlen($value, "UTF-8");
+                if ($length > 10) {
+                    throw new ProtoApi\GeneralException("'tags' is longer than 10");
+                }
+            }
+        }
+        if (!isset($this->quotas)) {
+            throw new ProtoApi\GeneralException("'quotas' is not exist");
+        }
+        $count = isset($this->quotas) ? count($this->quotas) : 0;
+        if ($count > 3) {
+            throw new ProtoApi\GeneralException("'quotas' has more than 3 items");
+        }
+        if (isset($this->quotas)) {
+            foreach ($this->quotas as $value) {
+                if ($value < 1) {
+                    throw new ProtoApi\GeneralException("'quotas' is less than 1");
+                }
+            }
+        }
+        if (!isset($this->avatar)) {
+            throw new ProtoApi\GeneralException("'avatar' is not exist");
+        }
+        if (isset($this->avatar)) {
+            foreach (array($this->avatar) as $value) {
+                $length = strlen(base64_decode($value));
+                if ($length > 1024) {
+                    throw new ProtoApi\GeneralException("'avatar' is longer than 1024");
+                }
+            }
+        }
+        if (empty($this->manager)) {
+            throw new ProtoApi\GeneralException("'manager' is required");
+        }
+    }
+    
+    public function set_name($name)
+    {
+        $this->name = $name;
+    }
+
+    public function get_name()
+    {
+        return $this->name;
+    }
+    
+    public function set_email($email)
+    {
+        $this->email = $email;
+    }
+
+    public function get_email()
+    {
+        return $this->email;
+    }
+    
+    public function set_code($code)
+    {
+        $this->code = $code;
+    }
+
+    public function get_code()
+    {
+        return $this->code;
+    }
+    
+    public function set_age($age)
+    {
+        $this->age = $age;
+    }
+
+    public function get_age()
+    {
+        return $this->age;
+    }
+    
+    public function set_score($score)
+    {
+        $this->score = $score;
+    }
+
+    public function get_score()
+    {
+        return $this->score;
+    }
+    
+    public function set_role($role)
+    {
+        $this->role = $role;
+    }
+
+    public function get_role()
+    {
+        return $this->role;
+    }
+    
+    public function set_tags($tags)
+    {
+        $this->tags = $tags;
+    }
+
+    public function get_tags()
+    {
+        return $this->tags;
+    }
+    
+    public function set_quotas(array $quotas)
+    {
+        $this->quotas = $quotas;
+    }
+
+    public function get_quotas()
+    {
+        return $this->quotas;
+    }
+    
+    public function set_avatar($avatar)
+    {
+        $this->avatar = $avatar;
+    }
+
+    public function get_avatar()
+    {
+        return $this->avatar;
+    }
+    
+    public function set_manager(Manager $manager)
+    {
+        $this->manager = $manager;
+    }
+
+    public function get_manager()
+    {
+        return $this->manager;
+    }
+    
+    public function to_array()
+    {
+        return array(
+            "name" => $this->name,
+            "email" => $this->email,
+            "code" => $this->code,
+            "age" => $this->age,
+            "score" => $this->score,
+            "role" => $this->role,
+            "tags" => $this->tags,
+            "quotas" => $this->quotas,
+            "avatar" => $this->avatar,
+            "manager" => $this->manager === null ? null : $this->manager->to_array(),
+        );
+    }
+}
+
+/** Enums **/
+class ValidateErrorType extends Enum
+{
+    const INVALID_EMAIL = 0;
+    const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
+}
+
+class Role extends Enum
+{
+    const GUEST = 0;
+    const MEMBER = 1;
+    const ADMIN = 2;
+}
+
+class AccountService
+{
+    protected $httpClient;
+
+    public function __construct($baseUri = '127.0.0.1:8080')
+    {
+        $this->httpClient = new ProtoApi\HttpClient(
+            array(
+                'base_uri' => $baseUri,
+                'timeout' => 30,
+            )
+        );
+    }
+    
+    public function create(Account $req)
+    {
+        $handler = function ($response, $bizerror, $common) {
+            if (!empty($response)) {
+                $res = new Account();
+                $res->init($response);
+                $res->validate();
+                return $res;
+            } else if (!empty($bizerror)) {
+                $bizError = new ();
+                $bizError->init($bizerror);
+                throw $bizError;
+            } else if (!empty($common)) {
+                if (isset($common["genericError"])) {
+                    $genericError = new GenericError();
+                    $genericError->init($common["genericError"]);
+                    throw $genericError;
+                } else if (isset($common["authError"])) {
+                    $authError = new AuthError();
+                    $authError->init($common["authError"]);
+                    throw $authError;
+                } else if (isset($common["validateError"])) {
+                    $validateError = new ValidateError();
+                    $validateError->init($common["validateError"]);
+                    throw $validateError;
+                } else if (isset($common["bindError"])) {
+                    $bindError = new BindError();
+                    $bindError->init($common["bindError"]);
+                    throw $bindError;
+                } else {
+                    throw new ProtoApi\GeneralException("Unknown common error type: ".$response);
+                }
+            }
+            throw new ProtoApi\GeneralException("No data returned.");
+        };
+
+        return $this->httpClient->callApi($req, "post", "AccountService.create", $handler);
+    }
+}
@@ -0,0 +1,67 @@
+/**
+* This file is generated by 'protoapi'
+* The file contains frontend API code that work with fetch API for HTTP usages
+* The generated code is written in TypeScript
+* The code provides a basic usage for API call and may need adjustment according to specific project requirement and situation
+* -------------------------------------------
+* 该文件生成于protoapi
+* 文件包含前端调用API的代码，并使用fetch做HTTP调用
+* 文件内代码使用TypeScript
+* 该生成文件只提供前端API调用基本代码，实际情况可能需要根据具体项目具体要求不同而作出更改
+*/
+import {
+    Item,
+    ItemRequest,
+    
+} from './ItemServiceObjs';
+import { generateQueryUrl, errorHandling } from './helper';
+
+var baseUrl = "http://192.168.115.60:8080";
+
+export function SetBaseUrl(url: string) {
+    baseUrl = url;
+}// use fetch
+// GET, HEAD and DELETE requests send the params in the query string, the others in the JSON body
+function call<InType, OutType>(url: string, params: InType, httpMethod: string): Promise<OutType | never> {
+    let init: RequestInit = { method: httpMethod };
+    if (httpMethod === 'GET' || httpMethod === 'HEAD' || httpMethod === 'DELETE') {
+        url = generateQueryUrl(url, params);
+    } else {
+        init.body = JSON.stringify(params);
+    }
+
+    return fetch(url, init).then(res => {
+        return Promise.resolve(res.json())
+    }).catch(err => {
+        return errorHandling(err)
+    });
+}
+/** served with POST by default */
+export function create(params: Item): Promise<Item | never> {
+    return call<Item, Item>(baseUrl + "/ItemService.create", params, "POST");
+}
+
+export function find(params: ItemRequest): Promise<Item | never> {
+    return call<ItemRequest, Item>(baseUrl + "/ItemService.find", params, "GET");
+}
+
+export function exists(params: ItemRequest): Promise<Item | never> {
+    return call<ItemRequest, Item>(baseUrl + "/ItemService.exists", params, "HEAD");
+}
+
+export function update(params: Item): Promise<Item | never> {
+    return call<Item, Item>(baseUrl + "/ItemService.update", params, "PUT");
+}
+
+export function rename(params: Item): Promise<Item | never> {
+    return call<Item, Item>(baseUrl + "/ItemService.rename", params, "PATCH");
+}
+
+export function remove(params: ItemRequest): Promise<Item | never> {
+    return call<ItemRequest, Item>(baseUrl + "/ItemService.remove", params, "DELETE");
+}
+
+/** the first verb is used by the clients */
+export function search(params: ItemRequest): Promise<Item | never> {
+    return call<ItemRequest, Item>(baseUrl + "/ItemService.search", params, "GET");
+}
@@ -212,7 +212,7 @@ class FieldError implements ProtoApi\Message
     }
 }
 
-class Empty implements ProtoApi\Message
+class Blank implements ProtoApi\Message
 {
 
     public function init(array $response)
@@ -230,6 +230,7 @@ class Empty implements ProtoApi\Message
     }
 }
 
+/** env list - 环境 */
 class EnvListRequest implements ProtoApi\Message
 {
 
@@ -290,6 +291,7 @@ class EnvListResponse implements ProtoApi\Message
     }
 }
 
+/** tag list - 用于分类 */
 class TagListRequest implements ProtoApi\Message
 {
 
@@ -350,8 +352,10 @@ class TagListResponse implements ProtoApi\Message
     }
 }
 
+/** product list */
 class ProductListRequest implements ProtoApi\Message
 {
+    /** DEV, UAT, PROD etc */
     protected $env_id;
 
     public function init(array $response)
@@ -428,8 +432,10 @@ class ProductListResponse implements ProtoApi\Message
     }
 }
 
+/** service list */
 class ServiceListRequest implements ProtoApi\Message
 {
+    /** optional, for filter */
     protected $tag_ids;
     protected $env_id;
     protected $offset;
@@ -521,8 +527,13 @@ class ServiceListRequest implements ProtoApi\Message
     }
 }
 
+/** service list */
 class ServiceSearchRequest implements ProtoApi\Message
 {
+    /**
+     * optional, for filter
+     * string prefix = 2 [ (val_required) = true, (val_format) = "email" ];
+     */
     protected $tag_ids;
     protected $prefix;
     protected $env_id;
@@ -559,6 +570,9 @@ class ServiceSearchRequest implements ProtoApi\Message
         if (!isset($this->prefix)) {
             throw new ProtoApi\GeneralException("'prefix' is not exist");
         }
+        if (empty($this->prefix)) {
+            throw new ProtoApi\GeneralException("'prefix' is required");
+        }
         if (!isset($this->env_id)) {
             throw new ProtoApi\GeneralException("'env_id' is not exist");
         }
@@ -728,6 +742,11 @@ class ServiceListResponse implements ProtoApi\Message
     }
 }
 
+/**
+ * key list
+ * PS: separate key list & key value because proto and kv store may be on
+ * different endpoint
+ */
 class KeyListRequest implements ProtoApi\Message
 {
     protected $service_id;
@@ -824,6 +843,7 @@ class KeyListResponse implements ProtoApi\Message
     }
 }
 
+/** key value */
 class KeyValueListRequest implements ProtoApi\Message
 {
     protected $service_id;
@@ -1700,6 +1720,7 @@ class UploadProtoFileResponse implements ProtoApi\Message
     }
 }
 
+/** other building blocks */
 class Env implements ProtoApi\Message
 {
     protected $env_id;
@@ -1754,6 +1775,7 @@ class Env implements ProtoApi\Message
     }
 }
 
+/** 用于分类 */
 class Tag implements ProtoApi\Message
 {
     protected $tag_id;
@@ -2247,9 +2269,7 @@ class KVHistoryItem implements ProtoApi\Message
             $this->updated_by = $response["updated_by"];
         }
         if (isset($response["revision"])) {
-            $this->revision = new Int64();
-            $this->revision->init($response["revision"]);
-            $this->revision->validate();
+            $this->revision = $response["revision"];
         }
     }
 
@@ -2299,7 +2319,7 @@ class KVHistoryItem implements ProtoApi\Message
         return $this->updated_by;
     }
     
-    public function set_revision(Revision $revision)
+    public function set_revision($revision)
     {
         $this->revision = $revision;
     }
@@ -2315,7 +2335,7 @@ class KVHistoryItem implements ProtoApi\Message
             "updated_value" => $this->updated_value,
             "updated_date" => $this->updated_date,
             "updated_by" => $this->updated_by,
-            "revision" => $this->revision->to_array(),
+            "revision" => $this->revision,
         );
     }
 }
@@ -2379,6 +2399,11 @@ class ValidateErrorType extends Enum
 {
     const INVALID_EMAIL = 0;
     const FIELD_REQUIRED = 1;
+    const OUT_OF_RANGE = 2;
+    const INVALID_LENGTH = 3;
+    const PATTERN_MISMATCH = 4;
+    const INVALID_ITEM_COUNT = 5;
+    const UNDEFINED_ENUM_VALUE = 6;
 }
 
 class ErrorCode extends Enum
@@ -2390,6 +2415,11 @@ class ErrorCode extends Enum
     const SystemError = 1003;
 }
 
+/**
+ * This service contains all the rpc related with services
+ * option (service_method) can be "POST" or "GET", if not specified, both post
+ * and get methods will be supported
+ */
 class AppService
 {
     protected $httpClient;
@@ -2404,6 +2434,7 @@ class AppService
         );
     }
     
+    /** get env */
     public function getEnv(EnvListRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2443,6 +2474,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.getEnv", $handler);
     }
 
+    /** register a service */
     public function registerService(RegisterServiceRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2482,6 +2514,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.registerService", $handler);
     }
 
+    /** update a service */
     public function updateService(UpdateServiceRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2521,6 +2554,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.updateService", $handler);
     }
 
+    /** upload proto file */
     public function uploadProtoFile(UploadProtoFileRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2560,6 +2594,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.uploadProtoFile", $handler);
     }
 
+    /** get a list of tags that contains apps */
     public function getTags(TagListRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2599,6 +2634,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.getTags", $handler);
     }
 
+    /** get a list of apps under specified tag */
     public function getProducts(ProductListRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2638,6 +2674,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.getProducts", $handler);
     }
 
+    /** get a list of services under specified tag */
     public function getServices(ServiceListRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2677,6 +2714,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.getServices", $handler);
     }
 
+    /** search services */
     public function searchServices(ServiceSearchRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2716,6 +2754,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.searchServices", $handler);
     }
 
+    /** get a list of keys of the specified service */
     public function getKeyList(KeyListRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2755,6 +2794,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.getKeyList", $handler);
     }
 
+    /** get key-value pairs given the list of keys */
     public function getKeyValueList(KeyValueListRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2794,6 +2834,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.getKeyValueList", $handler);
     }
 
+    /** search key value list by key or value */
     public function searchKeyValueList(SearchKeyValueListRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2833,6 +2874,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.searchKeyValueList", $handler);
     }
 
+    /** update value by key */
     public function updateKeyValue(KeyValueRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -2872,6 +2914,7 @@ class AppService
         return $this->httpClient->callApi($req, "post", "AppService.updateKeyValue", $handler);
     }
 
+    /** fetch key's value change history */
     public function fetchKeyHistory(KVHistoryRequest $req)
     {
         $handler = function ($response, $bizerror, $common) {
@@ -0,0 +1,6 @@
+#!/bin/sh
+# a protoapi external generator writing the model it receives to model.json,
+# the params holding the absolute path of this script are left out.
+# run by: protoapi gen --lang=exec:plugin/model.sh <output dir> <proto file>
+model=$(sed 's/"params":{[^}]*},//; s/\\/\\\\/g; s/"/\\"/g')
+printf '{"files":{"model.json":"%s\\n"}}' "$model"
@@ -6,6 +6,22 @@
   ../protoapi gen --lang=go result/go proto/calc.proto
   ../protoapi gen --lang=go result/go proto/todolist.proto
   ../protoapi gen --lang=go result/go proto/nested.proto
+  ../protoapi gen --lang=go result/go proto/map.proto
+  ../protoapi gen --lang=go result/go proto/oneof.proto
+  ../protoapi gen --lang=go result/go proto/wkt.proto
+  ../protoapi gen --lang=go result/go proto/scalar.proto
+  ../protoapi gen --lang=go result/go proto/optional.proto
+  ../protoapi gen --lang=go --json_naming=camel result/go proto/jsonname.proto
+  ../protoapi gen --lang=go result/go proto/validation.proto
+  ../protoapi gen --lang=go result/go proto/verb.proto
+  ../protoapi gen --lang=go result/go proto/path.proto
+  ../protoapi gen --lang=go result/go proto/gateway.proto
+  ../protoapi gen --lang=go result/go proto/stream.proto
+  ../protoapi gen --lang=go --custom_params=deprecation_headers=true result/go proto/deprecated.proto
+  ../protoapi gen --lang=go result/go proto/comment.proto
+  ../protoapi gen --lang=go result/go proto/reserved.proto
+  ../protoapi gen --lang=go result/go proto/recursive.proto
+  ../protoapi gen --lang=go result/go proto/services.proto
 
   diff -I "^//.*$" -r result/go/ expected/go/
 }
@@ -29,6 +45,20 @@
   diff -r result/package/go/ expected/package/go/
 }
 
+@test "multiple proto files go output" {
+  ../protoapi gen --lang=go --custom_params=go_import_prefix=github.com/yoozoo/protoapi/test/result/multi/go result/multi/go proto/calc.proto proto/todolist.proto
+  diff -I "^//.*$" -r result/multi/go/ expected/multi/go/
+  go build ./result/multi/go/...
+}
+
+@test "descriptor set go output" {
+  protoapi_home=${PROTOAPI_PATH:-$HOME/.protoapi}
+  $protoapi_home/bin/protoc --proto_path=$protoapi_home/include --proto_path=proto --include_imports --include_source_info \
+    --descriptor_set_out=result/descset/api.pb proto/calc.proto proto/todolist.proto
+  ../protoapi gen --lang=go --custom_params=go_import_prefix=github.com/yoozoo/protoapi/test/result/multi/go --descriptor_set_in=result/descset/api.pb result/descset/go
+  diff -I "^//.*$" -r result/descset/go/ expected/multi/go/
+}
+
 @test "test.proto ts output" {
   ../protoapi gen --lang=ts result/ts proto/test.proto
   ../protoapi gen --lang=ts-fetch result/ts/fetch proto/test.proto
@@ -36,6 +66,11 @@
   diff -I "^//.*$" -r result/ts/ expected/ts/
 }
 
+@test "calc.proto multiple services ts output" {
+  ../protoapi gen --lang=ts result/multi/ts proto/calc.proto
+  diff -I "^//.*$" -r result/multi/ts/ expected/multi/ts/
+}
+
 @test "test.proto spring output" {
   ../protoapi gen --lang=spring result/ proto/test.proto
   diff -I "^//.*$" -r result/com/yoozoo/spring/ expected/com/yoozoo/spring/
@@ -46,6 +81,173 @@
   diff -I "^//.*$" -r result/yoozoo.protoconf.ts/ expected/yoozoo.protoconf.ts/
 }
 
+@test "validation.proto phpclient output" {
+  ../protoapi gen --lang=phpclient result/ proto/validation.proto
+  diff -I "^//.*$" -r result/validations/ expected/validations/
+}
+
+@test "verb.proto http verbs output" {
+  ../protoapi gen --lang=spring result/ proto/verb.proto
+  ../protoapi gen --lang=ts-axios result/verbs/ts/axios proto/verb.proto
+  ../protoapi gen --lang=ts-fetch result/verbs/ts/fetch proto/verb.proto
+  ../protoapi gen --lang=phpclient result/ proto/verb.proto
+  ../protoapi gen --lang=markdown result/ proto/verb.proto
+  diff -I "^//.*$" -r result/com/yoozoo/verbs/ expected/com/yoozoo/verbs/
+  diff -I "^//.*$" -r result/verbs/ expected/verbs/
+}
+
+@test "path.proto path templates output" {
+  ../protoapi gen --lang=spring result/ proto/path.proto
+  ../protoapi gen --lang=ts-axios result/paths/ts/axios proto/path.proto
+  ../protoapi gen --lang=ts-fetch result/paths/ts/fetch proto/path.proto
+  ../protoapi gen --lang=phpclient result/ proto/path.proto
+  ../protoapi gen --lang=markdown result/ proto/path.proto
+  ../protoapi gen --lang=goclient result/paths/ proto/path.proto
+  diff -I "^//.*$" -r result/com/yoozoo/paths/ expected/com/yoozoo/paths/
+  diff -I "^//.*$" -r result/paths/ expected/paths/
+}
+
+@test "path.proto go client and server round trip" {
+  ../protoapi gen --lang=go result/go proto/path.proto
+  ../protoapi gen --lang=goclient result/paths/ proto/path.proto
+  go run test_path.go
+}
+
+@test "gateway.proto google.api.http output" {
+  ../protoapi gen --lang=spring result/ proto/gateway.proto
+  ../protoapi gen --lang=ts-axios result/gateway/ts/axios proto/gateway.proto
+  ../protoapi gen --lang=ts-fetch result/gateway/ts/fetch proto/gateway.proto
+  ../protoapi gen --lang=phpclient result/ proto/gateway.proto
+  ../protoapi gen --lang=markdown result/ proto/gateway.proto
+  diff -I "^//.*$" -r result/com/yoozoo/gateway/ expected/com/yoozoo/gateway/
+  diff -I "^//.*$" -r result/gateway/ expected/gateway/
+}
+
+@test "gateway.proto unsupported body warning output" {
+  run ../protoapi gen --lang=go result/go proto/gateway.proto
+  [ "$status" -eq 0 ]
+  [[ "$output" == *"BookService.moveBook: google.api.http: body \"book\" is not supported, the whole input is sent as the body"* ]]
+  [[ "$output" == *"BookService.moveBook: google.api.http: response_body \"title\" is not supported, the whole output is sent as the body"* ]]
+}
+
+@test "stream.proto server-streaming output" {
+  ../protoapi gen --lang=ts-axios result/stream/ts/axios proto/stream.proto
+  ../protoapi gen --lang=ts-fetch result/stream/ts/fetch proto/stream.proto
+  ../protoapi gen --lang=markdown result/ proto/stream.proto
+  ../protoapi gen --lang=goclient result/stream/ proto/stream.proto
+  diff -I "^//.*$" -r result/stream/ expected/stream/
+  go build ./result/stream/stream/
+}
+
+@test "deprecated.proto deprecation output" {
+  ../protoapi gen --lang=spring result/ proto/deprecated.proto
+  ../protoapi gen --lang=ts-axios result/deprecation/ts/axios proto/deprecated.proto
+  ../protoapi gen --lang=ts-fetch result/deprecation/ts/fetch proto/deprecated.proto
+  ../protoapi gen --lang=markdown result/ proto/deprecated.proto
+  diff -I "^//.*$" -r result/com/yoozoo/deprecation/ expected/com/yoozoo/deprecation/
+  diff -I "^//.*$" -r result/deprecation/ expected/deprecation/
+}
+
+@test "comment.proto doc comments output" {
+  ../protoapi gen --lang=spring result/ proto/comment.proto
+  ../protoapi gen --lang=ts-axios result/comments/ts/axios proto/comment.proto
+  ../protoapi gen --lang=ts-fetch result/comments/ts/fetch proto/comment.proto
+  ../protoapi gen --lang=phpclient result/ proto/comment.proto
+  ../protoapi gen --lang=markdown result/ proto/comment.proto
+  diff -I "^//.*$" -r result/com/yoozoo/comments/ expected/com/yoozoo/comments/
+  diff -I "^//.*$" -r result/comments/ expected/comments/
+}
+
+@test "reserved.proto reserved word output" {
+  ../protoapi gen --lang=spring result/ proto/reserved.proto
+  ../protoapi gen --lang=ts-axios result/reserved/ts/axios proto/reserved.proto
+  ../protoapi gen --lang=phpclient result/ proto/reserved.proto
+  diff -I "^//.*$" -r result/com/yoozoo/reserved/ expected/com/yoozoo/reserved/
+  diff -I "^//.*$" -r result/reserved/ expected/reserved/
+}
+
+@test "recursive.proto recursive message output" {
+  ../protoapi gen --lang=spring result/ proto/recursive.proto
+  ../protoapi gen --lang=ts-axios result/recursive/ts/axios proto/recursive.proto
+  ../protoapi gen --lang=phpclient result/ proto/recursive.proto
+  ../protoapi gen --lang=markdown result/ proto/recursive.proto
+  diff -I "^//.*$" -r result/com/yoozoo/recursive/ expected/com/yoozoo/recursive/
+  diff -I "^//.*$" -r result/recursive/ expected/recursive/
+}
+
+@test "map.proto map output" {
+  ../protoapi gen --lang=ts-axios result/maps/ts/axios proto/map.proto
+  ../protoapi gen --lang=spring result/ proto/map.proto
+  ../protoapi gen --lang=phpclient result/ proto/map.proto
+  diff -I "^//.*$" -r result/maps/ expected/maps/
+}
+
+@test "jsonname.proto json keys output" {
+  ../protoapi gen --lang=ts-axios result/jsonnames/ts/axios proto/jsonname.proto
+  ../protoapi gen --lang=spring result/ proto/jsonname.proto
+  ../protoapi gen --lang=phpclient result/ proto/jsonname.proto
+  ../protoapi gen --lang=markdown result/ proto/jsonname.proto
+  diff -I "^//.*$" -r result/jsonnames/ expected/jsonnames/
+}
+
+@test "oneof.proto oneof output" {
+  ../protoapi gen --lang=ts-axios result/oneofs/ts/axios proto/oneof.proto
+  ../protoapi gen --lang=spring result/ proto/oneof.proto
+  diff -I "^//.*$" -r result/oneofs/ expected/oneofs/
+}
+
+@test "scalar.proto scalar types output" {
+  ../protoapi gen --lang=ts-axios result/scalars/ts/axios proto/scalar.proto
+  ../protoapi gen --lang=spring result/ proto/scalar.proto
+  ../protoapi gen --lang=phpclient result/ proto/scalar.proto
+  diff -I "^//.*$" -r result/scalars/ expected/scalars/
+}
+
+@test "scalar.proto 64-bit integers round trip" {
+  ../protoapi gen --lang=go result/go proto/scalar.proto
+  go run test_scalar.go
+}
+
+@test "services.proto common error per service output" {
+  ../protoapi gen --lang=ts-fetch result/services/ts/fetch proto/services.proto
+  ../protoapi gen --lang=ts-axios result/services/ts/axios proto/services.proto
+  ../protoapi gen --lang=goclient result/services/ proto/services.proto
+  ../protoapi gen --lang=spring result/services/ proto/services.proto
+  ../protoapi gen --lang=phpclient result/services/ proto/services.proto
+  ../protoapi gen --lang=yii2 result/services/ proto/services.proto
+  ../protoapi gen --lang=markdown result/services/ proto/services.proto
+  diff -I "^//.*$" -r result/services/ expected/services/
+}
+
+@test "invalid.proto generation error output" {
+  run ../protoapi gen --lang=go result/go proto/invalid.proto
+  [ "$status" -eq 1 ]
+  [[ "$output" == *"invalids.Account.name: min and max are only for number fields"* ]]
+}
+
+@test "invalid_unsigned.proto negative bound error output" {
+  run ../protoapi gen --lang=go result/go proto/invalid_unsigned.proto
+  [ "$status" -eq 1 ]
+  [[ "$output" == *"invalids.Account.age: min and max must not be negative for unsigned fields"* ]]
+}
+
+@test "lint.proto lint output" {
+  ../protoapi lint proto/verb.proto
+  # the warnings alone do not fail the command
+  ../protoapi lint proto/gateway.proto
+  run ../protoapi lint proto/lint.proto
+  [ "$status" -eq 1 ]
+  diff <(../protoapi lint proto/lint.proto 2>/dev/null) expected/lint/lint.txt
+}
+
+@test "diff old and new versions output" {
+  ../protoapi diff --proto_path=proto proto/diff/old proto/diff/old
+  run ../protoapi diff --proto_path=proto proto/diff/old proto/diff/new
+  [ "$status" -eq 1 ]
+  diff <(../protoapi diff --proto_path=proto proto/diff/old proto/diff/new 2>/dev/null) expected/diff/breaking.txt
+  diff <(../protoapi diff --changelog --proto_path=proto proto/diff/old proto/diff/new 2>/dev/null) expected/diff/changelog.md
+}
+
 @test "todolist.proto php yii2 output" {
   ../protoapi gen --lang=yii2 result/ proto/todolist.proto
   diff -I "^//.*$" -r result/app/ expected/app/
@@ -55,3 +257,23 @@
   ../protoapi gen --lang=markdown result/ proto/login.proto
   diff -I "^//.*$" -r result/markdown/ expected/markdown/
 }
+
+@test "calc.proto exec plugin output" {
+  ../protoapi gen --lang=exec:plugin/model.sh result/exec proto/calc.proto
+  diff -r result/exec/ expected/exec/
+}
+
+@test "extension.proto custom options in exec plugin output" {
+  ../protoapi gen --lang=exec:plugin/model.sh result/extension proto/extension.proto
+  diff -r result/extension/ expected/extension/
+}
+
+@test "extclash.proto custom options named like the protoapi options" {
+  ../protoapi gen --lang=exec:plugin/model.sh result/extclash proto/extclash.proto
+  diff -r result/extclash/ expected/extclash/
+}
+
+@test "calc.proto ts output with user templates" {
+  ../protoapi gen --lang=ts --template_dir=template result/template/ts proto/calc.proto
+  diff -I "^//.*$" -r result/template/ts/ expected/template/ts/
+}
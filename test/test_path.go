@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/labstack/echo"
+	"github.com/yoozoo/protoapi/test/result/go/pathsvr"
+	"github.com/yoozoo/protoapi/test/result/paths/paths"
+)
+
+type orderService struct{}
+
+// ListOrders returns an order holding the request, the amount is the limit given in the query,
+// and a second one holding the reference when it is set
+func (s *orderService) ListOrders(c echo.Context, req *pathsvr.OrderListRequest) (resp *pathsvr.OrderList, err error) {
+	resp = new(pathsvr.OrderList)
+	resp.Orders = []*pathsvr.Order{{User_id: req.User_id, Order_id: req.State.String(), Amount: req.Limit}}
+	if x, ok := req.Filter.(*pathsvr.OrderListRequest_Reference); ok {
+		resp.Orders = append(resp.Orders, &pathsvr.Order{User_id: req.User_id, Order_id: x.Reference})
+	}
+	return
+}
+
+func (s *orderService) UpdateOrder(c echo.Context, req *pathsvr.Order) (resp *pathsvr.Order, err error) {
+	return req, nil
+}
+
+func (s *orderService) CreateOrder(c echo.Context, req *pathsvr.Order) (resp *pathsvr.Order, err error) {
+	return req, nil
+}
+
+func (s *orderService) OrderServiceAuth(c echo.Context) (err error) {
+	return
+}
+
+// main checks that the fields of a GET request that are not in the path reach the server
+func main() {
+	e := echo.New()
+	pathsvr.RegisterOrderService(e, &orderService{})
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	// the go client sends the fields in the query string
+	client := new(paths.OrderService)
+	client.SetApiURL(server.URL + "/")
+	list, err := client.ListOrders(&paths.OrderListRequest{User_id: 42, State: paths.CLOSED, Limit: 7})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(list.Orders) != 1 || list.Orders[0].User_id != 42 || list.Orders[0].Order_id != "CLOSED" || list.Orders[0].Amount != 7 {
+		log.Fatalf("unexpected go client response: %+v", list.Orders)
+	}
+
+	// the ts clients send the same query string
+	resp := getOrders(server.URL + "/api/v1/users/42/orders/1?limit=9")
+	if len(resp.Orders) != 1 || resp.Orders[0].User_id != 42 || resp.Orders[0].Order_id != "CLOSED" || resp.Orders[0].Amount != 9 {
+		log.Fatalf("unexpected ts query response: %+v", resp.Orders)
+	}
+
+	// a numeric string of a oneof member stays a string
+	resp = getOrders(server.URL + "/api/v1/users/42/orders/0?reference=123")
+	if len(resp.Orders) != 2 || resp.Orders[1].Order_id != "123" {
+		log.Fatalf("unexpected oneof query response: %+v", resp.Orders)
+	}
+
+	// the query parameters that are not fields, like cache busters, are ignored
+	resp = getOrders(server.URL + "/api/v1/users/42/orders/0?limit=3&_=1540000000000&utm_source=mail")
+	if len(resp.Orders) != 1 || resp.Orders[0].Amount != 3 {
+		log.Fatalf("unexpected response to unknown query parameters: %+v", resp.Orders)
+	}
+}
+
+// getOrders returns the orders listed by the url, any error status fails the test
+func getOrders(url string) *pathsvr.OrderList {
+	res, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		log.Fatalf("GET %s returned status %d", url, res.StatusCode)
+	}
+	resp := new(pathsvr.OrderList)
+	if err = json.NewDecoder(res.Body).Decode(resp); err != nil {
+		log.Fatal(err)
+	}
+	return resp
+}
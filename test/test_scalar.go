@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/labstack/echo"
+	"github.com/yoozoo/protoapi/test/result/go/scalarsvr"
+)
+
+type scalarService struct{}
+
+func (s *scalarService) Echo(c echo.Context, req *scalarsvr.Scalars) (resp *scalarsvr.Scalars, err error) {
+	return req, nil
+}
+
+// main checks that the 64-bit integers are JSON numbers kept exactly by the go services,
+// the values above 2^53 lose precision in the numbers of the ts clients only
+func main() {
+	e := echo.New()
+	scalarsvr.RegisterScalarService(e, &scalarService{})
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	values := []string{`"i64":-9223372036854775808`, `"u64":18446744073709551615`, `"s64":9007199254740993`, `"f64":9007199254740993`, `"sf64":-9007199254740993`}
+	body := "{" + strings.Join(values, ",") + "}"
+	res, err := http.Post(server.URL+"/ScalarService.echo", "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer res.Body.Close()
+	resp, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		log.Fatalf("unexpected status %d: %s", res.StatusCode, resp)
+	}
+	for _, value := range values {
+		if !strings.Contains(string(resp), value) {
+			log.Fatalf("%s not kept in %s", value, resp)
+		}
+	}
+}
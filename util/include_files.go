@@ -3,6 +3,7 @@ package util
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -12,17 +13,19 @@ const embeddedDir = "/proto/"
 
 // GetIncludePath returns the actual include path
 func GetIncludePath(userPath string, filePath string) string {
-	// extract common includes if needed
-	path, err := ioutil.TempDir("", "protoapi_inc_")
-	if err != nil {
-		panic(err)
-	}
+	// extract common includes if needed, once for all the protoc runs of the command
+	if len(protoapiInc) == 0 {
+		path, err := ioutil.TempDir("", "protoapi_inc_")
+		if err != nil {
+			panic(err)
+		}
 
-	err = ExtractIncludes(path)
-	if err != nil {
-		panic(err)
+		err = ExtractIncludes(path)
+		if err != nil {
+			panic(err)
+		}
+		protoapiInc = path
 	}
-	protoapiInc = path
 
 	// return path concatenated with user include path
 	var result = protoapiInc + string(os.PathListSeparator) + filePath
@@ -50,14 +53,19 @@ func ExtractIncludes(path string) error {
 		}
 	}()
 
-	for n := range _escData {
-		if strings.HasPrefix(n, embeddedDir) {
-			name := strings.TrimPrefix(n, embeddedDir)
+	for n, f := range _escData {
+		// the directories are created along with their files, ie google/api/http.proto
+		if strings.HasPrefix(n, embeddedDir) && !f.isDir {
+			name := filepath.Join(path, filepath.FromSlash(strings.TrimPrefix(n, embeddedDir)))
 			data, err := FSByte(false, n)
 			if err != nil {
 				return err
 			}
-			err = ioutil.WriteFile(path+string(os.PathSeparator)+name, data, 0666)
+			err = os.MkdirAll(filepath.Dir(name), 0777)
+			if err != nil {
+				return err
+			}
+			err = ioutil.WriteFile(name, data, 0666)
 			if err != nil {
 				return err
 			}
@@ -6,8 +6,23 @@ import (
 
 //GetPHPClassName rename class to valid php class name
 func GetPHPClassName(old string) string {
+	// empty is reserved in php, use the class name of the official protobuf php library
+	if old == "google.protobuf.Empty" {
+		return "GPBEmpty"
+	}
+	// a message named empty keeps the class name of the earlier versions
 	if strings.ToUpper(old) == "EMPTY" {
 		return "Blank"
 	}
+	// the other reserved names get the PB prefix, like the official library does
+	if phpReservedClassNames[strings.ToLower(old)] {
+		return "PB" + strings.Title(old)
+	}
 	return strings.Title(old)
 }
+
+// GetPHPRegex returns a regular expression as a php string literal for preg_match
+func GetPHPRegex(pattern string) string {
+	pattern = strings.Replace(pattern, "~", `\~`, -1)
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace("~"+pattern+"~u") + "'"
+}
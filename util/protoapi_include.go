@@ -209,19 +209,85 @@ func FSMustString(useLocal bool, name string) string {
 
 var _escData = map[string]*_escFile{
 
+	"/proto/google/api/annotations.proto": {
+		name:    "annotations.proto",
+		local:   "proto/google/api/annotations.proto",
+		size:    1045,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/2RTUW+jRhB+51d84qmVXEhdRWlr+YE6aYLq2pFxGuXJWcMYJoXdvd0ltnW6/35aDBf7
+jieY+eabb74Z4hgzpY+Gy8phfPXrNe6VKmvCfD4L4jiIY8w5J2mpQCsLMnAVIdEir2jIjPAfGctKYhxd
+4ScPCPtU+PPEUxxVi0YcIZVDawmuYosd1wQ65KQdWCJXja5ZyJywZ1d1fXqWyHO89Bxq6wRLCORKH6F2
+50AI14v2T+Wc/jOO9/t9JDrBkTJlXJ+gNp6ns7tFdvfLOLrqi55kTdbC0KeWDRXYHiG0rjkX25pQiz2U
+gSgNUQGnvOi9YceyHMGqndsLQ56mYOsMb1t34dkgke0FQEkIiTDJkGYh/kqyNBt5kud0/bB8WuM5Wa2S
+xTq9y7BcYbZc3KbrdLnIsPwbyeIF/6SL2xGIXUUGdNDGT6AM2LtJRWddRnQhYadOkqymnHecoxaybEVJ
+KNU7GcmyhCbTsPVbtRCy8DQ1N+yE60I/zBUFgT1KJw6YItRGOfVbOAkCLfL/T8T+qiKheRIE3GhlHMJT
+MBaaY7+rqCsLJ9/nu/C23cUF2dywdsp8gwZKe0Eo1WZoNR0Ko1L5wbqtlyS7kviUEppt11dIqfqZJmfv
+4WQgfhPvYtO0tWNd08bfrMUUzrR0CVGtI7PJa2GtFE2nIvnge+zlnlec6c1VE3049IFT27f8xLnRhnbc
+mXufPKZ+cjo4ksXg7GBS9C+5ShVLfdrT5wDoL+D1wTm9amt6jQJg+Oj+EkxxMx7/cX0z/n0SfAm+DgB0
+HJEQFQQAAA==
+`,
+	},
+
+	"/proto/google/api/http.proto": {
+		name:    "http.proto",
+		local:   "proto/google/api/http.proto",
+		size:    4307,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/6RX32/bOBJ+918xMHDYNnClNnvdPTTIgy9xG+OySWA7VyyKg0NTI4m7FMkjKbtG2//9
+MCQly0laHLB5CARp+M03H+eX8xwutNlbUdUeTl+/eQsftK4kwvX1xSjPR3kO14KjclhAqwq04GuEqWG8
+xu7LBP6N1gmt4DR7DS/IYJw+jV+eEcRet9CwPSjtoXUIvhYOSiER8DNH40Eo4LoxUjDFEXbC18FPQskI
+4/eEoTeeCQUMuDZ70OXQEJhPpOmv9t68y/PdbpexQDjTtsplNHX59fxidrOcvTrNXqdD90qic2Dxv62w
+WMBmD8wYKTjbSATJdqAtsMoiFuA1kd5Z4YWqJuB06XfMIsEUwnkrNq0/0qyjKNyRgVbAFIynS5gvx/DP
+6XK+nBDIx/nq6vZ+BR+ni8X0ZjWfLeF2ARe3N5fz1fz2Zgm372F68zv8a35zOQEUvkYL+NlYikBbEKQm
+FkG6JeIRhVJHSs4gF6XgIJmqWlYhVHqLVglVgUHbCEe36oCpgmCkaIRnPrx6Elc2Grm98uwznMPYWO31
+z+Oz0cgw/mcEpqzKmBFno5E2BAKcr1GRtGtmUTEH5+Bti2fd90qvu+PnME4IlSay4SYrVMFRHj8xI1zO
+jMiZUjrxPBs8j3vgP9iWrZtWemEkrikPn/gOJrr1aNdcMucUawKLK+/NHTl9hDYgynWTHcI92OnNHzyC
+rY3FUgSlPkzv5qRTnsMllkKhC6JerVZ3wLUqRdXaQD9cGlMwvZuDQ7sVHDOYezKienDAQArnQZeE9Yl4
+LlqJ//l0oJL1LyeAjNfp/vd03eS0YcbQsy7J0eLuAhr0tQ537zVohaAtNNomfovZchX4RDOXjRp0jlQg
+R/BlBJDnMO14PReUbWWImPlQaftYVoXYiqJl8hic0MI/ODm5uV3N3p2cwFTKTo1nkUstpd7BWDKioKi1
+KDcGbQu0hGjRIPNYQCdNOncOb85G0dnHGhU49ESNUmQC94trMMzXYJhlDXq0DnZCStgglK2Ue7hfzF8V
+yHWBxaHDRTjOHDpSwwlFfdZh1aDy0DDPa3QgFFikkMJRwxSV4AR2NVqE8d9O3487XxFPYukBVfA11GhV
+IxRYslZ62GDNtkJbEI6iUNpDZAeL9xfwy9tfXx988ppZxkNMQkEokgj4iCj52mgtY8TriLfuYNY9dTiH
+07PRt5DhFeXUyjLluC4o04QDBiUy39rYlboU3KDfISpg8UzMAepDKQsJ7VEioiqMFsq7UBaM7t1BgVuU
+2lA0XsOmFbIA1kk/KKWQ4pSFrjVGW+9go30dnU/v5qEF9vnusjQvSGMKHwrNWxInpl6aSV0sIihJbw59
+ihJPO+G13b8jJBpV7l2eV8LX7Sbjuhk2tcHjRupN3jDn0abXoeXR+Sw0w6MaDBmd6nCJErknwZOaXsOu
+FryO05jyPk47PKq1BZZoyfaTCwDaHrWUy2Hk5C7rzcKFxpkQoQr0TMiATvNPVdDZDuvtEj2Nnq4TpmLz
+aBUIl7IvjOaetstgRc+dGWeqL4/WYRH3Cab6beFLhf6raf1Xo53/WqBEj18NIX/r2w1MgbfO6ya96aqX
+oKmwhKLp7rre+VM0/glKgTIUolaoy54TXUJA+I2ZkIshcT/MVhncE0XSivok4VGuVejDs1Cltk3MK7bR
+re9wLDrdWh4vqxe0Qh8L7ll3d/dDdxaNZDw47NGOwExLYD9/D+x2OUTjFpk/BgNtwaClAGJYwDgFcuxE
+O/Ly9+94uZxdz1azgZ9wWz8gHb7THH77Pd7T1cXVALA1BfsRYMgLOIdfDnhU9yk5BqnZdoiDscpUdNqV
+HLUY4UDp/h6F4rItsOh6xENCfIiZNAHX8hqYg6vZ9HJCko5PxhSMRLYNa12HNHTUqkhCJEp9rWSB/E7I
+4hVntgjvEvmylR1UiCJ2xjSdjdVbUYQh61GFYfgRN/DiavXb9UvgUiB13nD+IigTFqWuIMMbOId/nI0A
+vo0OEyqsVakqae1G52PcsKu1Q9gy2WKse2Pi1t2vR8k+gm10sQ/qPJw8HE0SJuUxclAfODM0dFIjwTDP
+I1J3o0NXPbpuhPdJU4KhuRqueX/EKRwYdtGwr6QoS7TkOIbZtGSNYCw60pX5YOW1eSVpdj0SJ6J1Ld7v
+DQ66KTmFc/i166S3Ye9kMntGamd0+CXw/2k96lpOPBWii4tREmQSTFF5YQfoHc9uOaIKiVDMDe9xCPtE
+tNVfES0i/1C1zmid5Htz2uk3LQoRFUxZQJupqtzh91MaXxncoPNYHCyIYQRRut/RgSl4YD3ourNOpU6Q
+jUO5RQcvUqeYRBBypjBOh/AbWMk9CaAVQoy4QDQvn99on/FIYb7ptrLpj3pZmHTkNtkEIbZoN4dl/2m9
+f3muwIV7BqO/hT+FKoZbwCrV5JOBnzAeHQ+madP83wDefuIo0xAAAA==
+`,
+	},
+
 	"/proto/protoapi_common.proto": {
 		name:    "protoapi_common.proto",
 		local:   "proto/protoapi_common.proto",
-		size:    890,
+		size:    1768,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/4RSzW7yMBC85ylWPAD/fF8rlAMtoYoEVKUt18gkS7AU26ntIBDi3SvbCSQqLbf17GRm
-djfqyDU5gA+tXAotBq2x51GWC6mhlQqRZtixjU2x7SSoYklzLWTbYoaLB408AUdtV9T2AvVOJK+5poIr
-OHkASkvKU1Ao9zTGiFkC+DDqdbv/xlcCSilkif8fe+dfLd6d0g2PWDAmeFRXejAOGyEyIIXeleDjX/Iz
-itmtAfYki7ZCMqJLlf5F2rQkfhVUYjXZwDQp14M+MMpLcFgD7e4NOLJhGCpFUoRnO0JgJzDmL8hR0tgB
-af3hQ8/ITQq9cwC5VD7YbGuS0YRodOC+8fLBJnyiPHHA5lL5MGxEakQ4VeuouiYG1NjXPHepV/O71OYo
-J5CYI9GYgL2WQ+3Z1Y9Pa4zaNbcGXRKG1R4bDh/HHJ2erew+zR/DC3aDaGTD5XoyD6dRsJiEc/ChazRn
-YTCfRqvg7TNcBVPndPa+AwAA//85Zq4RegMAAA==
+H4sIAAAAAAAC/4RUUW/iOBB+768Y8dwtobTdXSEeuJJ2I0G4o6GvkYmHxLrYztkOAqH97yc7DknE3u0T
+8cznmW++b4w+C0NOMIdRpaSR09Hs7o7xSioDo1zKvMSxS+zrw5iizhSrjFQPLmaxeDIoKDTQhxb6sEZT
+SLqpDJNCw+UOQBvFRA4a1ZFlmHIHgDk8T4LgZdYBUCmpfPyrjY/HUBFTgEFelcQgyAOYAqGpcA8MYTSu
+NSo9vtiflNGfY6koKj26t8i2hiIcDSoNeyaoK8FEVRs4MCypbstqwhEE4dhRcu0do8mLZ0QtEQIUK4UZ
+MUg9HWAaFHJ5RM/sMZh8/xK8fJkGo3vQKAww0ZH6qIVGAwUSigr2Z5uBXDqVUOmebA2wIfFtdvfzP5X/
+aAT+hfSZ5FyKtC/wNzvOXsoSSN3OGHzvq14pPLDTUPNOq6ZX12JPNKY9ub7+H9M3q/sveB5JmR6k4sTP
+GzxeWdqUwn9qprDdnalnK2qOimUWUiMoInLLiwkzfQTOhEc/zbogOfngsy/h+5coclNYn7KCKJLZnbmH
+/dmg7uXc+VrMEuNMpD7fTB/MhnlyGuYnvq/CvC6JAjxVCrVmUgBpyfBaG+DEZMVQoIoYg8qPNXn0lZhB
+DpmshbEWKazQ7SYRFDip/KbfkLa3tK80veXcTz/5Rihqfn04ojyDIX+j2wmKWUmsPc4I3XeO4oEJpKm7
+0NR7dgvCUWuSI7y6DQ3dgtqFeEdhPW0Cef8wByfeojZFEyDXrzk4NT5JyewrbYLHwWkObsw/mKBNYH/9
+msPTgNKAwuXqis9aGtBDd3x+C+2a/xY6HOXS+epeUBN1r1rfXO0hei/MGRfbPzqv46BDcq4Q8Prl9LSv
+2Fp+C7Rlo/hzsYqWabheRCuYg1v8tyhcLdNt+Ncu2obLttNml6Sbt3S7iN/D1qr2+iqM35MfrTt/LpIk
+3MbpOvpYL5LXH401HTpKwnX6utnFid0lm9nFy/AtisNlGsa7dfq5WO1sjxdL/98BADV7IXXoBgAA
 `,
 	},
 
@@ -230,11 +296,33 @@ YTCfRqvg7TNcBVPndPa+AwAA//85Zq4RegMAAA==
 		local: `proto`,
 		isDir: true,
 	},
+
+	"/proto/google": {
+		name:  "google",
+		local: `proto/google`,
+		isDir: true,
+	},
+
+	"/proto/google/api": {
+		name:  "api",
+		local: `proto/google/api`,
+		isDir: true,
+	},
 }
 
 var _escDirs = map[string][]os.FileInfo{
 
 	"proto": {
+		_escData["/proto/google"],
 		_escData["/proto/protoapi_common.proto"],
 	},
+
+	"proto/google": {
+		_escData["/proto/google/api"],
+	},
+
+	"proto/google/api": {
+		_escData["/proto/google/api/annotations.proto"],
+		_escData["/proto/google/api/http.proto"],
+	},
 }
@@ -0,0 +1,95 @@
+package util
+
+import (
+	"strings"
+)
+
+// goReservedWords are the go keywords and the predeclared identifiers, a constant named after
+// a predeclared identifier compiles but hides it from the rest of the package
+var goReservedWords = toSet(
+	// keywords
+	"break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for",
+	"func", "go", "goto", "if", "import", "interface", "map", "package", "range", "return", "select",
+	"struct", "switch", "type", "var",
+	// predeclared identifiers
+	"bool", "byte", "complex64", "complex128", "error", "float32", "float64", "int", "int8", "int16",
+	"int32", "int64", "rune", "string", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+	"true", "false", "iota", "nil", "append", "cap", "close", "complex", "copy", "delete", "imag",
+	"len", "make", "new", "panic", "print", "println", "real", "recover",
+)
+
+// javaReservedWords are the java keywords and literals
+var javaReservedWords = toSet(
+	"abstract", "assert", "boolean", "break", "byte", "case", "catch", "char", "class", "const",
+	"continue", "default", "do", "double", "else", "enum", "extends", "final", "finally", "float",
+	"for", "goto", "if", "implements", "import", "instanceof", "int", "interface", "long", "native",
+	"new", "package", "private", "protected", "public", "return", "short", "static", "strictfp",
+	"super", "switch", "synchronized", "this", "throw", "throws", "transient", "try", "void",
+	"volatile", "while", "true", "false", "null", "_",
+)
+
+// tsReservedWords are the words that can not name a function in the strict mode of the ts modules
+var tsReservedWords = toSet(
+	"break", "case", "catch", "class", "const", "continue", "debugger", "default", "delete", "do",
+	"else", "enum", "export", "extends", "false", "finally", "for", "function", "if", "import", "in",
+	"instanceof", "new", "null", "return", "super", "switch", "this", "throw", "true", "try",
+	"typeof", "var", "void", "while", "with", "implements", "interface", "let", "package", "private",
+	"protected", "public", "static", "yield", "await", "arguments", "eval",
+)
+
+// phpReservedClassNames are the php keywords and the reserved class names, lower cased as php
+// does not tell them apart by case
+var phpReservedClassNames = toSet(
+	"__halt_compiler", "abstract", "and", "array", "as", "break", "callable", "case", "catch",
+	"class", "clone", "const", "continue", "declare", "default", "die", "do", "echo", "else",
+	"elseif", "empty", "enddeclare", "endfor", "endforeach", "endif", "endswitch", "endwhile",
+	"eval", "exit", "extends", "final", "finally", "fn", "for", "foreach", "function", "global",
+	"goto", "if", "implements", "include", "include_once", "instanceof", "insteadof", "interface",
+	"isset", "list", "match", "namespace", "new", "or", "print", "private", "protected", "public",
+	"readonly", "require", "require_once", "return", "static", "switch", "throw", "trait", "try",
+	"unset", "use", "var", "while", "xor", "yield",
+	"int", "float", "bool", "string", "true", "false", "null", "void", "iterable", "object",
+	"mixed", "never", "self", "parent",
+)
+
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
+
+// SafeGoName returns the name to declare in go, the reserved ones get an underscore appended, ie type_
+func SafeGoName(name string) string {
+	if goReservedWords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// SafeJavaName returns the name to declare in java, the reserved ones get an underscore appended,
+// ie class_ like the official protobuf java library
+func SafeJavaName(name string) string {
+	if javaReservedWords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// SafeTSName returns the name to declare in ts, the reserved ones get an underscore appended, ie delete_
+func SafeTSName(name string) string {
+	if tsReservedWords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// GetPHPConstName returns the name of a class constant, class is the only reserved one.
+// It gets the PB prefix like the official protobuf php library
+func GetPHPConstName(name string) string {
+	if strings.ToLower(name) == "class" {
+		return "PB" + name
+	}
+	return name
+}